@@ -0,0 +1,223 @@
+package wnc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/ap"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/client"
+)
+
+// DefaultFleetParallelism is the default number of controllers queried concurrently.
+const DefaultFleetParallelism = 10
+
+// Fleet holds named clients for many controllers and runs calls across them concurrently.
+type Fleet struct {
+	clients     map[string]*Client // Clients keyed by controller name
+	names       []string           // Controller names in sorted order
+	parallelism int                // Maximum number of concurrent controller calls
+	timeout     time.Duration      // Per-controller timeout, zero uses the caller's context only
+}
+
+// FleetOption is a functional option for configuring a Fleet.
+type FleetOption func(*Fleet) error
+
+// WithFleetParallelism limits the number of controllers called concurrently.
+func WithFleetParallelism(n int) FleetOption {
+	return func(f *Fleet) error {
+		if n < 1 {
+			return fmt.Errorf("fleet parallelism must be at least 1, got %d", n)
+		}
+		f.parallelism = n
+		return nil
+	}
+}
+
+// WithFleetTimeout bounds the duration of each controller call.
+func WithFleetTimeout(timeout time.Duration) FleetOption {
+	return func(f *Fleet) error {
+		if timeout <= 0 {
+			return fmt.Errorf("fleet timeout must be positive, got %v", timeout)
+		}
+		f.timeout = timeout
+		return nil
+	}
+}
+
+// NewFleet creates a fleet from clients keyed by controller name.
+func NewFleet(clients map[string]*Client, opts ...FleetOption) (*Fleet, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("fleet initialization failed: at least one client is required")
+	}
+	for name, c := range clients {
+		if strings.TrimSpace(name) == "" {
+			return nil, errors.New("fleet initialization failed: controller name cannot be empty")
+		}
+		if c == nil {
+			return nil, fmt.Errorf("fleet initialization failed: client for %q is nil", name)
+		}
+	}
+
+	fleet := &Fleet{
+		clients:     maps.Clone(clients),
+		names:       slices.Sorted(maps.Keys(clients)),
+		parallelism: DefaultFleetParallelism,
+	}
+	for _, opt := range opts {
+		if err := opt(fleet); err != nil {
+			return nil, fmt.Errorf("fleet initialization failed: %w", err)
+		}
+	}
+	return fleet, nil
+}
+
+// Names returns the controller names in sorted order.
+func (f *Fleet) Names() []string {
+	return slices.Clone(f.names)
+}
+
+// Client returns the client registered under name.
+func (f *Fleet) Client(name string) (*Client, bool) {
+	c, ok := f.clients[name]
+	return c, ok
+}
+
+// FleetResults holds per-controller outcomes of a fleet call, keyed by controller name.
+// Every controller appears in exactly one of Values or Errors.
+type FleetResults[T any] struct {
+	Values map[string]T
+	Errors map[string]error
+}
+
+// Err returns a *FleetError describing the failed controllers, or nil when all succeeded.
+func (r FleetResults[T]) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	return &FleetError{Errors: maps.Clone(r.Errors)}
+}
+
+// FleetError reports the controllers that failed during a fleet call.
+type FleetError struct {
+	Errors map[string]error // Errors keyed by controller name
+}
+
+func (e *FleetError) Error() string {
+	names := slices.Sorted(maps.Keys(e.Errors))
+	descriptions := make([]string, len(names))
+	for i, name := range names {
+		descriptions[i] = fmt.Sprintf("%s: %v", name, e.Errors[name])
+	}
+	return fmt.Sprintf("fleet call failed on %d controller(s): %s", len(names), strings.Join(descriptions, "; "))
+}
+
+// Unwrap returns the controller errors so that errors.Is and errors.As inspect each of them.
+func (e *FleetError) Unwrap() []error {
+	names := slices.Sorted(maps.Keys(e.Errors))
+	errs := make([]error, len(names))
+	for i, name := range names {
+		errs[i] = e.Errors[name]
+	}
+	return errs
+}
+
+// FanOut calls fn for every controller of the fleet concurrently, honoring the fleet
+// parallelism and per-controller timeout, and collects the results by controller name.
+func FanOut[T any](
+	ctx context.Context, f *Fleet, fn func(ctx context.Context, name string, c *Client) (T, error),
+) FleetResults[T] {
+	results := FleetResults[T]{Values: map[string]T{}, Errors: map[string]error{}}
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, f.parallelism)
+	)
+
+	record := func(name string, value T, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			results.Errors[name] = err
+			return
+		}
+		results.Values[name] = value
+	}
+
+	for _, name := range f.names {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			var zero T
+			record(name, zero, ctx.Err())
+			continue
+		}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+			callCtx, cancel := f.callContext(ctx)
+			defer cancel()
+			value, err := fn(callCtx, name, f.clients[name])
+			record(name, value, err)
+		})
+	}
+	wg.Wait()
+	return results
+}
+
+// callContext derives the context of a single controller call.
+func (f *Fleet) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if f.timeout > 0 {
+		return context.WithTimeout(ctx, f.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// FleetItem is a list entry tagged with the controller it was retrieved from.
+type FleetItem[T any] struct {
+	Controller string
+	Item       T
+}
+
+// MergeFleetLists flattens the successful results into entries tagged with their controller.
+// Entries are ordered by controller name, then by their position in each result.
+func MergeFleetLists[R, T any](results FleetResults[R], items func(R) []T) []FleetItem[T] {
+	var merged []FleetItem[T]
+	for _, name := range slices.Sorted(maps.Keys(results.Values)) {
+		for _, item := range items(results.Values[name]) {
+			merged = append(merged, FleetItem[T]{Controller: name, Item: item})
+		}
+	}
+	return merged
+}
+
+// ListCAPWAPData lists the joined APs of every controller, tagged with their controller.
+// On partial failure the entries of the healthy controllers are returned with a *FleetError.
+func (f *Fleet) ListCAPWAPData(ctx context.Context) ([]FleetItem[ap.CAPWAPData], error) {
+	results := FanOut(ctx, f,
+		func(ctx context.Context, _ string, c *Client) (*ap.CiscoIOSXEWirelessApOperCAPWAPData, error) {
+			return c.AP().ListCAPWAPData(ctx)
+		})
+	merged := MergeFleetLists(results, func(r *ap.CiscoIOSXEWirelessApOperCAPWAPData) []ap.CAPWAPData {
+		return r.CAPWAPData
+	})
+	return merged, results.Err()
+}
+
+// ListClients lists the associated wireless clients of every controller, tagged with their controller.
+// On partial failure the entries of the healthy controllers are returned with a *FleetError.
+func (f *Fleet) ListClients(ctx context.Context) ([]FleetItem[client.CommonOperData], error) {
+	results := FanOut(ctx, f,
+		func(ctx context.Context, _ string, c *Client) (*client.CiscoIOSXEWirelessClientOperCommonOperData, error) {
+			return c.Client().ListCommonInfo(ctx)
+		})
+	merged := MergeFleetLists(results, func(r *client.CiscoIOSXEWirelessClientOperCommonOperData) []client.CommonOperData {
+		return r.CommonOperData
+	})
+	return merged, results.Err()
+}
@@ -0,0 +1,188 @@
+package wnc
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/pkg/testutil"
+)
+
+// fleetCAPWAPPath is the CAPWAP data path served by the fleet test controllers.
+const fleetCAPWAPPath = "Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data/capwap-data"
+
+const fleetCAPWAPBody = `{"Cisco-IOS-XE-wireless-access-point-oper:capwap-data":[` +
+	`{"wtp-mac":"aa:bb:cc:dd:ee:01","name":"AP-1"},{"wtp-mac":"aa:bb:cc:dd:ee:02","name":"AP-2"}]}`
+
+// newFleetTestClient returns a client for a controller replying with two CAPWAP entries.
+func newFleetTestClient(t *testing.T, opts ...testutil.MockServerOption) *Client {
+	t.Helper()
+	return newMockTestClient(t, append([]testutil.MockServerOption{
+		testutil.WithSuccessResponse(fleetCAPWAPPath, fleetCAPWAPBody),
+	}, opts...)...)
+}
+
+// TestNewFleet tests fleet construction and option validation.
+func TestNewFleet(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient("controller.example.com", "dGVzdDp0ZXN0")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	testCases := []struct {
+		name        string
+		clients     map[string]*Client
+		opts        []FleetOption
+		expectError bool
+	}{
+		{name: "Valid", clients: map[string]*Client{"b": c, "a": c}},
+		{name: "ValidWithOptions", clients: map[string]*Client{"a": c},
+			opts: []FleetOption{WithFleetParallelism(2), WithFleetTimeout(time.Second)}},
+		{name: "NoClients", clients: nil, expectError: true},
+		{name: "EmptyName", clients: map[string]*Client{" ": c}, expectError: true},
+		{name: "NilClient", clients: map[string]*Client{"a": nil}, expectError: true},
+		{name: "ZeroParallelism", clients: map[string]*Client{"a": c},
+			opts: []FleetOption{WithFleetParallelism(0)}, expectError: true},
+		{name: "ZeroTimeout", clients: map[string]*Client{"a": c},
+			opts: []FleetOption{WithFleetTimeout(0)}, expectError: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			fleet, err := NewFleet(tt.clients, tt.opts...)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			want := strings.Join(slices.Sorted(maps.Keys(tt.clients)), ",")
+			if got := strings.Join(fleet.Names(), ","); got != want {
+				t.Errorf("Expected names %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+// TestFleetListCAPWAPDataPartialFailure tests merged results and partial failure reporting.
+func TestFleetListCAPWAPDataPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	fleet, err := NewFleet(map[string]*Client{
+		"wnc-tokyo": newFleetTestClient(t),
+		"wnc-osaka": newFleetTestClient(t),
+		"wnc-down":  newMockTestClient(t, testutil.WithErrorResponse(fleetCAPWAPPath, http.StatusServiceUnavailable)),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create fleet: %v", err)
+	}
+
+	items, err := fleet.ListCAPWAPData(context.Background())
+
+	var fleetErr *FleetError
+	if !errors.As(err, &fleetErr) {
+		t.Fatalf("Expected FleetError, got %v", err)
+	}
+	if len(fleetErr.Errors) != 1 || fleetErr.Errors["wnc-down"] == nil {
+		t.Errorf("Expected only wnc-down to fail, got %v", fleetErr.Errors)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected wrapped APIError with status 503, got %v", err)
+	}
+
+	if len(items) != 4 {
+		t.Fatalf("Expected 4 merged APs, got %d", len(items))
+	}
+	want := []string{"wnc-osaka/AP-1", "wnc-osaka/AP-2", "wnc-tokyo/AP-1", "wnc-tokyo/AP-2"}
+	for i, item := range items {
+		if got := item.Controller + "/" + item.Item.Name; got != want[i] {
+			t.Errorf("Item %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+}
+
+// TestFanOutTimeoutAndParallelism tests the per-controller timeout and the parallelism bound.
+func TestFanOutTimeoutAndParallelism(t *testing.T) {
+	t.Parallel()
+
+	clients := map[string]*Client{}
+	for _, name := range []string{"a", "b", "c", "d"} {
+		clients[name] = newFleetTestClient(t, testutil.WithResponseDelay(50*time.Millisecond))
+	}
+	clients["hung"] = newFleetTestClient(t, testutil.WithResponseDelay(time.Minute))
+
+	fleet, err := NewFleet(clients, WithFleetParallelism(2), WithFleetTimeout(500*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Failed to create fleet: %v", err)
+	}
+
+	var inflight, peak atomic.Int32
+	results := FanOut(context.Background(), fleet,
+		func(ctx context.Context, _ string, c *Client) (int, error) {
+			n := inflight.Add(1)
+			defer inflight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			data, err := c.AP().ListCAPWAPData(ctx)
+			if err != nil {
+				return 0, err
+			}
+			return len(data.CAPWAPData), nil
+		})
+
+	if len(results.Values) != 4 || len(results.Errors) != 1 {
+		t.Fatalf("Expected 4 values and 1 error, got %v and %v", results.Values, results.Errors)
+	}
+	if !errors.Is(results.Errors["hung"], context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded for hung controller, got %v", results.Errors["hung"])
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("Expected at most 2 concurrent calls, observed %d", p)
+	}
+	if results.Values["a"] != 2 {
+		t.Errorf("Expected 2 APs from controller a, got %d", results.Values["a"])
+	}
+}
+
+// TestFanOutCanceledContext tests that controllers not yet started report the cancellation.
+func TestFanOutCanceledContext(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient("controller.example.com", "dGVzdDp0ZXN0")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	fleet, err := NewFleet(map[string]*Client{"a": c, "b": c}, WithFleetParallelism(1))
+	if err != nil {
+		t.Fatalf("Failed to create fleet: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := FanOut(ctx, fleet, func(ctx context.Context, _ string, _ *Client) (struct{}, error) {
+		return struct{}{}, ctx.Err()
+	})
+
+	if len(results.Errors) != 2 {
+		t.Errorf("Expected both controllers to fail, got %v", results.Errors)
+	}
+	if results.Err() == nil {
+		t.Error("Expected FleetError from Err()")
+	}
+}
@@ -0,0 +1,142 @@
+package wnc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	ierrors "github.com/umatare5/cisco-ios-xe-wireless-go/internal/errors"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf"
+)
+
+// Path is a RESTCONF data resource path built from a YANG module, data nodes and list keys
+// (type alias to restconf.Path). List keys are percent-encoded as required by RFC 8040.
+type Path = restconf.Path
+
+// Operation is a RESTCONF operation (RPC) resource of a YANG module (type alias to restconf.Operation).
+type Operation = restconf.Operation
+
+// NewPath starts a data path at the top-level node of a YANG module, e.g.
+//
+//	wnc.NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data").
+//		Node("wlan-cfg-entries").Entry("wlan-cfg-entry", "corp")
+func NewPath(module, node string) Path { return restconf.NewPath(module, node) }
+
+// NewOperation returns the operation resource of an RPC defined by a YANG module.
+func NewOperation(module, rpc string) Operation { return restconf.NewOperation(module, rpc) }
+
+// Get retrieves the resource at path and decodes the response document into T.
+// T mirrors the RFC 7951 JSON encoding including the module-qualified top-level member.
+func Get[T any](ctx context.Context, c *Client, path Path, opts ...QueryOption) (*T, error) {
+	if err := checkGenericCall(c, path.Err()); err != nil {
+		return nil, err
+	}
+	return core.Get[T](ctx, c.core, path.String(), opts...)
+}
+
+// GetWithMeta is like Get but also returns the ETag and Last-Modified validators of the response,
+// and accepts conditions such as IfNoneMatch or IfModifiedSince. When the controller answers
+// HTTP 304 Not Modified, the result is nil and meta.NotModified is set.
+func GetWithMeta[T any](
+	ctx context.Context, c *Client, path Path, conds []Condition, opts ...QueryOption,
+) (*T, *ResponseMeta, error) {
+	if err := checkGenericCall(c, path.Err()); err != nil {
+		return nil, nil, err
+	}
+	return core.GetWithMeta[T](ctx, c.core, path.String(), conds, opts...)
+}
+
+// Patch merges payload into the resource at path. The payload is the complete request document,
+// such as map[string]any{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry": entry}.
+func Patch(ctx context.Context, c *Client, path Path, payload any, conds ...Condition) error {
+	if err := checkGenericCall(c, path.Err()); err != nil {
+		return err
+	}
+	return core.PatchVoid(ctx, c.core, path.String(), payload, conds...)
+}
+
+// Put creates or replaces the resource at path with payload, the complete request document.
+func Put(ctx context.Context, c *Client, path Path, payload any, conds ...Condition) error {
+	if err := checkGenericCall(c, path.Err()); err != nil {
+		return err
+	}
+	return core.PutVoid(ctx, c.core, path.String(), payload, conds...)
+}
+
+// Delete removes the resource at path.
+func Delete(ctx context.Context, c *Client, path Path) error {
+	if err := checkGenericCall(c, path.Err()); err != nil {
+		return err
+	}
+	return core.Delete(ctx, c.core, path.String())
+}
+
+// InvokeRPC invokes the operation with input and decodes its output into Out. The input is
+// wrapped in the "module:input" member and the "module:output" member is unwrapped, so In and Out
+// describe only the RPC input and output nodes. Out is left zero when the RPC returns no output.
+func InvokeRPC[In, Out any](ctx context.Context, c *Client, op Operation, input In) (*Out, error) {
+	if err := checkGenericCall(c, op.Err()); err != nil {
+		return nil, err
+	}
+
+	body, err := c.core.DoRPCWithPayload(ctx, http.MethodPost, op.String(), map[string]In{op.InputMember(): input})
+	if err != nil {
+		return nil, err
+	}
+
+	var out Out
+	if len(body) == 0 {
+		return &out, nil
+	}
+	if err := core.UnmarshalMember(body, op.OutputMember(), &out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &out, nil
+}
+
+// YANGPatch batches create, merge, replace and delete edits into one atomic request
+// (type alias to core.YANGPatch). Edit targets are data paths such as Path.String().
+type YANGPatch = core.YANGPatch
+
+// YANGPatchEdit is a single edit of a YANG Patch (type alias to core.YANGPatchEdit).
+type YANGPatchEdit = core.YANGPatchEdit
+
+// PatchOperation is the operation of a YANG Patch edit (type alias to core.PatchOperation).
+type PatchOperation = core.PatchOperation
+
+// YANG Patch edit operations.
+const (
+	PatchCreate  = core.PatchCreate
+	PatchMerge   = core.PatchMerge
+	PatchReplace = core.PatchReplace
+	PatchDelete  = core.PatchDelete
+	PatchRemove  = core.PatchRemove
+)
+
+// YANGPatchResult is the outcome of a YANG Patch mapped to its edits (type alias to core.YANGPatchResult).
+type YANGPatchResult = core.YANGPatchResult
+
+// YANGEditStatus is the status of a single edit of a YANG Patch (type alias to core.YANGEditStatus).
+type YANGEditStatus = core.YANGEditStatus
+
+// NewYANGPatch returns an empty YANG Patch identified by patchID.
+func NewYANGPatch(patchID string) *YANGPatch { return core.NewYANGPatch(patchID) }
+
+// ApplyYANGPatch applies every edit of patch atomically in a single RFC 8072 request.
+// When the controller rejects the patch, the per-edit result is returned along with the error.
+func ApplyYANGPatch(ctx context.Context, c *Client, patch *YANGPatch) (*YANGPatchResult, error) {
+	if err := checkGenericCall(c, nil); err != nil {
+		return nil, err
+	}
+	return core.ApplyYANGPatch(ctx, c.core, patch)
+}
+
+// checkGenericCall validates the client and the path or operation of a generic call.
+func checkGenericCall(c *Client, pathErr error) error {
+	if c == nil || c.core == nil {
+		return errors.New(ierrors.ErrClientNil)
+	}
+	return pathErr
+}
@@ -0,0 +1,244 @@
+package wnc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/pkg/testutil"
+)
+
+// newMockTestClient starts a mock server configured with opts and returns a client for it.
+func newMockTestClient(t *testing.T, opts ...testutil.MockServerOption) *Client {
+	t.Helper()
+	server := testutil.NewMockServer(opts...)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(strings.TrimPrefix(server.URL(), "https://"), "dGVzdDp0ZXN0", WithInsecureSkipVerify(true))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+// decodeGenericBody decodes the top-level members of a recorded request body.
+func decodeGenericBody(t *testing.T, req testutil.RecordedRequest) map[string]json.RawMessage {
+	t.Helper()
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(req.Body), &body); err != nil {
+		t.Fatalf("Failed to decode %s %s body: %v", req.Method, req.Path, err)
+	}
+	return body
+}
+
+// TestGenericDataAccess tests Get, Patch, Put and Delete with typed paths.
+func TestGenericDataAccess(t *testing.T) {
+	t.Parallel()
+
+	type wlanEntry struct {
+		ProfileName string `json:"profile-name"`
+		WlanID      int    `json:"wlan-id"`
+	}
+	type wlanEntryDocument struct {
+		Entries []wlanEntry `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry"`
+	}
+
+	recorder := &testutil.RequestRecorder{}
+	entriesPath := "Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries"
+	c := newMockTestClient(t,
+		testutil.WithSuccessResponse(entriesPath+"/wlan-cfg-entry=corp/guest",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":[{"profile-name":"corp/guest","wlan-id":7}]}`),
+		testutil.WithRequestRecorder(recorder))
+	ctx := testutil.TestContext(t)
+	path := NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data").
+		Node("wlan-cfg-entries").Entry("wlan-cfg-entry", "corp/guest")
+	wantPath := "/restconf/data/" + entriesPath + "/wlan-cfg-entry=corp%2Fguest"
+
+	doc, err := Get[wlanEntryDocument](ctx, c, path, QueryDepth(1))
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(doc.Entries) != 1 || doc.Entries[0].WlanID != 7 {
+		t.Errorf("Unexpected document: %+v", doc)
+	}
+
+	payload := map[string]any{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry": map[string]any{"wlan-id": 8}}
+	if err := Patch(ctx, c, path, payload, IfMatch(`"v1"`)); err != nil {
+		t.Fatalf("Patch failed: %v", err)
+	}
+	if err := Put(ctx, c, path, payload); err != nil {
+		t.Errorf("Put failed: %v", err)
+	}
+	if err := Delete(ctx, c, path); err != nil {
+		t.Errorf("Delete failed: %v", err)
+	}
+
+	requests := recorder.Requests()
+	wantMethods := []string{http.MethodGet, http.MethodPatch, http.MethodPut, http.MethodDelete}
+	if len(requests) != len(wantMethods) {
+		t.Fatalf("Expected %d requests, got %d", len(wantMethods), len(requests))
+	}
+	for i, method := range wantMethods {
+		if requests[i].Method != method || requests[i].EscapedPath != wantPath {
+			t.Errorf("Request %d: expected %s %s, got %s %s", i, method, wantPath, requests[i].Method, requests[i].EscapedPath)
+		}
+	}
+	if ifMatch := requests[1].Header.Get("If-Match"); ifMatch != `"v1"` {
+		t.Errorf("Expected conditional PATCH, got If-Match %q", ifMatch)
+	}
+	if _, ok := decodeGenericBody(t, requests[1])["Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry"]; !ok {
+		t.Errorf("Expected payload document to be sent unchanged, got %s", requests[1].Body)
+	}
+}
+
+// TestGenericGetWithMeta tests validators and HTTP 304 responses of conditional GETs.
+func TestGenericGetWithMeta(t *testing.T) {
+	t.Parallel()
+
+	type wlanEntryDocument struct {
+		Entries []struct {
+			ProfileName string `json:"profile-name"`
+		} `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry"`
+	}
+
+	c := newMockTestClient(t,
+		testutil.WithSuccessResponse("Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries/wlan-cfg-entry=corp",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":[{"profile-name":"corp"}]}`),
+		testutil.WithEntityTags())
+	ctx := testutil.TestContext(t)
+	path := NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data").
+		Node("wlan-cfg-entries").Entry("wlan-cfg-entry", "corp")
+
+	doc, meta, err := GetWithMeta[wlanEntryDocument](ctx, c, path, nil)
+	if err != nil || doc == nil || len(doc.Entries) != 1 {
+		t.Fatalf("GetWithMeta failed: %v, %+v", err, doc)
+	}
+	if meta.ETag != `"v1"` || meta.NotModified {
+		t.Errorf("Expected ETag \"v1\" of a full response, got %+v", meta)
+	}
+
+	doc, meta, err = GetWithMeta[wlanEntryDocument](ctx, c, path, []Condition{IfNoneMatch(meta.ETag)})
+	if err != nil {
+		t.Fatalf("Conditional GetWithMeta failed: %v", err)
+	}
+	if doc != nil || !meta.NotModified || meta.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected 304 Not Modified without document, got %+v, %+v", doc, meta)
+	}
+
+	if err := Patch(ctx, c, path, map[string]any{}, meta.Conditions()...); err != nil {
+		t.Fatalf("Patch failed: %v", err)
+	}
+	doc, meta, err = GetWithMeta[wlanEntryDocument](ctx, c, path, []Condition{IfNoneMatch(`"v1"`)})
+	if err != nil || doc == nil || meta.NotModified || meta.ETag != `"v2"` {
+		t.Errorf("Expected changed document after write, got %+v, %+v, %v", doc, meta, err)
+	}
+
+	if _, _, err := GetWithMeta[wlanEntryDocument](ctx, nil, path, nil); err == nil {
+		t.Error("Expected error for nil client")
+	}
+}
+
+// TestGenericInvokeRPC tests input wrapping and output unwrapping of RPC calls.
+func TestGenericInvokeRPC(t *testing.T) {
+	t.Parallel()
+
+	type reloadInput struct {
+		Reason string `json:"reason"`
+	}
+	type reloadOutput struct {
+		Result string `json:"result"`
+	}
+
+	recorder := &testutil.RequestRecorder{}
+	c := newMockTestClient(t,
+		testutil.WithCustomResponse("Cisco-IOS-XE-rpc:reload", testutil.ResponseConfig{
+			StatusCode: http.StatusOK, Body: `{"Cisco-IOS-XE-rpc:output":{"result":"scheduled"}}`, Method: http.MethodPost,
+		}),
+		testutil.WithRequestRecorder(recorder))
+	op := NewOperation("Cisco-IOS-XE-rpc", "reload")
+
+	out, err := InvokeRPC[reloadInput, reloadOutput](context.Background(), c, op, reloadInput{Reason: "upgrade"})
+	if err != nil {
+		t.Fatalf("InvokeRPC failed: %v", err)
+	}
+	if out.Result != "scheduled" {
+		t.Errorf("Expected unwrapped output, got %+v", out)
+	}
+	writes := recorder.Writes()
+	if len(writes) != 1 || writes[0].Method != http.MethodPost ||
+		writes[0].Path != "/restconf/operations/Cisco-IOS-XE-rpc:reload" {
+		t.Fatalf("Unexpected requests %+v", writes)
+	}
+	if input := string(decodeGenericBody(t, writes[0])["Cisco-IOS-XE-rpc:input"]); !strings.Contains(input, `"upgrade"`) {
+		t.Errorf("Expected wrapped input, got %s", writes[0].Body)
+	}
+
+	empty := newMockTestClient(t, testutil.WithRequestRecorder(&testutil.RequestRecorder{}))
+	out, err = InvokeRPC[struct{}, reloadOutput](context.Background(), empty, op, struct{}{})
+	if err != nil || out == nil || out.Result != "" {
+		t.Errorf("Expected zero output for empty body, got %+v, %v", out, err)
+	}
+}
+
+// TestGenericValidation tests that invalid paths and clients fail before any request.
+func TestGenericValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	recorder := &testutil.RequestRecorder{}
+	c := newMockTestClient(t, testutil.WithRequestRecorder(recorder))
+	invalid := NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data").Node("bad node")
+
+	if _, err := Get[map[string]any](ctx, c, invalid); err == nil {
+		t.Error("Expected error for invalid path")
+	}
+	if err := Patch(ctx, c, Path{}, nil); err == nil {
+		t.Error("Expected error for empty path")
+	}
+	if _, err := InvokeRPC[struct{}, struct{}](ctx, c, NewOperation("mod", "bad rpc"), struct{}{}); err == nil {
+		t.Error("Expected error for invalid operation")
+	}
+	if err := Delete(ctx, nil, NewPath("mod", "node")); err == nil {
+		t.Error("Expected error for nil client")
+	}
+	if requests := recorder.Requests(); len(requests) != 0 {
+		t.Errorf("Expected no request, got %+v", requests)
+	}
+}
+
+// TestGenericApplyYANGPatch tests that a YANG Patch is sent to the datastore in one request.
+func TestGenericApplyYANGPatch(t *testing.T) {
+	t.Parallel()
+
+	recorder := &testutil.RequestRecorder{}
+	c := newMockTestClient(t,
+		testutil.WithCustomResponse("/restconf/data", testutil.ResponseConfig{
+			StatusCode: http.StatusOK,
+			Body:       `{"ietf-yang-patch:yang-patch-status":{"patch-id":"onboard","ok":[null]}}`,
+			Method:     http.MethodPatch,
+		}),
+		testutil.WithRequestRecorder(recorder))
+	siteTag := NewPath("Cisco-IOS-XE-wireless-site-cfg", "site-cfg-data").Node("site-tag-configs")
+	patch := NewYANGPatch("onboard").
+		Create(siteTag.String(), map[string]any{"Cisco-IOS-XE-wireless-site-cfg:site-tag-config": map[string]any{}}).
+		Delete(siteTag.Entry("site-tag-config", "old/tag").String())
+
+	result, err := ApplyYANGPatch(context.Background(), c, patch)
+	if err != nil || !result.OK || len(result.Edits) != 2 {
+		t.Fatalf("ApplyYANGPatch failed: %v, %+v", err, result)
+	}
+	writes := recorder.Writes()
+	if len(writes) != 1 || writes[0].Method != http.MethodPatch || writes[0].Path != "/restconf/data" {
+		t.Fatalf("Expected PATCH /restconf/data, got %+v", writes)
+	}
+	edits := string(decodeGenericBody(t, writes[0])["ietf-yang-patch:yang-patch"])
+	if !strings.Contains(edits, `site-tag-config=old%2Ftag`) {
+		t.Errorf("Expected escaped edit target, got %s", writes[0].Body)
+	}
+
+	if _, err := ApplyYANGPatch(context.Background(), nil, patch); err == nil {
+		t.Error("Expected error for nil client")
+	}
+}
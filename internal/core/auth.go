@@ -0,0 +1,80 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// WithAuthenticator replaces the authenticator used to sign requests.
+func WithAuthenticator(auth transport.Authenticator) Option {
+	return func(c *Client) error {
+		if auth == nil {
+			return fmt.Errorf("client configuration failed: %w",
+				errors.New("authenticator validation failed: authenticator cannot be nil"))
+		}
+		c.auth = auth
+		c.requestBuilder.SetAuthenticator(auth)
+		return nil
+	}
+}
+
+// WithSessionReuse enables reuse of controller session cookies. While a session cookie is held,
+// requests are sent without the Authorization header; the session is dropped on HTTP 401.
+func WithSessionReuse(enabled bool) Option {
+	return func(c *Client) error {
+		if !enabled {
+			c.session = nil
+			c.httpClient.Jar = nil
+			c.requestBuilder.SetSessionJar(nil)
+			return nil
+		}
+		c.session = transport.NewSessionJar()
+		c.httpClient.Jar = c.session
+		c.requestBuilder.SetSessionJar(c.session)
+		return nil
+	}
+}
+
+// reauthenticate drops any session and refreshes credentials after an HTTP 401.
+// It reports whether the request is worth repeating with new credentials.
+func (c *Client) reauthenticate(ctx context.Context) bool {
+	retry := false
+	if c.session != nil {
+		c.session.Reset()
+		retry = true
+	}
+	if refresher, ok := c.auth.(transport.Refresher); ok {
+		if err := refresher.Refresh(ctx); err != nil {
+			c.logger.Error("Failed to refresh credentials", "error", err)
+			return false
+		}
+		retry = true
+	}
+	if retry {
+		c.logger.Info("Re-authenticating after unauthorized response")
+	}
+	return retry
+}
+
+// reauthMiddleware repeats a call once with fresh credentials after an HTTP 401.
+func (c *Client) reauthMiddleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			resp, err := next.Do(ctx, req)
+			if err != nil && isUnauthorized(err) && c.reauthenticate(ctx) {
+				return next.Do(ctx, req)
+			}
+			return resp, err
+		})
+	}
+}
+
+// isUnauthorized reports whether err is an HTTP 401 API error.
+func isUnauthorized(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized
+}
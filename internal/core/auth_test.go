@@ -0,0 +1,121 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// TestCoreAuthUnit_NewWithAuthenticator tests constructor validation.
+func TestCoreAuthUnit_NewWithAuthenticator(t *testing.T) {
+	t.Run("ValidAuthenticator", func(t *testing.T) {
+		client, err := NewWithAuthenticator("test.example.com", transport.NewBasicAuth("admin", "pass"))
+		testutil.AssertClientCreated(t, client, err, "ValidAuthenticator")
+	})
+
+	t.Run("NilAuthenticator", func(t *testing.T) {
+		_, err := NewWithAuthenticator("test.example.com", nil)
+		testutil.AssertClientCreationError(t, err, "NilAuthenticator")
+	})
+
+	t.Run("NilAuthenticatorOption", func(t *testing.T) {
+		_, err := New("test.example.com", "token", WithAuthenticator(nil))
+		testutil.AssertClientCreationError(t, err, "NilAuthenticatorOption")
+	})
+}
+
+// TestCoreAuthUnit_Do_RefreshOnUnauthorized tests that credentials are refreshed once after a 401.
+func TestCoreAuthUnit_Do_RefreshOnUnauthorized(t *testing.T) {
+	validAuth := transport.HTTPHeaderValueBasicPrefix + transport.EncodeBasicToken("admin", "rotated")
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(transport.HTTPHeaderKeyAuthorization) != validAuth {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var lookups atomic.Int32
+	auth := transport.NewCredentialAuth(func(context.Context) (string, string, error) {
+		if lookups.Add(1) == 1 {
+			return "admin", "expired", nil
+		}
+		return "admin", "rotated", nil
+	})
+
+	client, err := NewWithAuthenticator(strings.TrimPrefix(server.URL, "https://"), auth, WithInsecureSkipVerify(true))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET should succeed after credential refresh")
+	testutil.AssertIntEquals(t, int(lookups.Load()), 2, "credential lookups")
+}
+
+// TestCoreAuthUnit_Do_StaticTokenUnauthorized tests that static tokens are not retried on 401.
+func TestCoreAuthUnit_Do_StaticTokenUnauthorized(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertError(t, err, "GET should fail with 401")
+	testutil.AssertIntEquals(t, int(calls.Load()), 1, "request count")
+}
+
+// TestCoreAuthUnit_Do_SessionReuse tests that session cookies replace the Authorization header.
+func TestCoreAuthUnit_Do_SessionReuse(t *testing.T) {
+	var withAuth, withCookie atomic.Int32
+	var expired atomic.Bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("session"); err == nil {
+			if expired.Load() && cookie.Value == "first" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			withCookie.Add(1)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.Header.Get(transport.HTTPHeaderKeyAuthorization) == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		value := "first"
+		if withAuth.Add(1) > 1 {
+			value = "second"
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: value, Path: "/"})
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithSessionReuse(true))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	ctx := context.Background()
+	for range 3 {
+		_, err := client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "GET should succeed")
+	}
+	testutil.AssertIntEquals(t, int(withAuth.Load()), 1, "requests with Authorization")
+	testutil.AssertIntEquals(t, int(withCookie.Load()), 2, "requests with session cookie")
+
+	expired.Store(true)
+	_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET should succeed after session renewal")
+	testutil.AssertIntEquals(t, int(withAuth.Load()), 2, "requests with Authorization after expiry")
+}
@@ -0,0 +1,328 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+)
+
+// Cache policy default values.
+const (
+	// DefaultCacheConfigTTL is the default lifetime of cached configuration (*-cfg) data.
+	DefaultCacheConfigTTL = 5 * time.Minute
+	// DefaultCacheOperTTL is the default lifetime of cached operational (*-oper) data.
+	DefaultCacheOperTTL = 10 * time.Second
+	// DefaultCacheMaxEntries is the default maximum number of cached responses.
+	DefaultCacheMaxEntries = 1024
+)
+
+// CachePolicy configures the in-memory response cache.
+//
+// Successful data GETs are cached per RESTCONF URL for the TTL of their route. A route TTL is
+// taken from the longest matching RouteTTLs prefix, otherwise from ConfigTTL or OperTTL depending
+// on the YANG module suffix. A zero TTL disables caching of the route. Any PUT, PATCH, POST,
+// DELETE or RPC call drops the cached paths of the same YANG module family, that is the module
+// name without its -cfg, -oper, -global-oper or -rpc suffix.
+type CachePolicy struct {
+	ConfigTTL  time.Duration            // Lifetime of *-cfg module data
+	OperTTL    time.Duration            // Lifetime of *-oper module data
+	RouteTTLs  map[string]time.Duration // Lifetime per route path prefix, overriding the module defaults
+	MaxEntries int                      // Maximum number of cached responses, zero means unlimited
+}
+
+// DefaultCachePolicy returns a cache policy populated with the package defaults.
+func DefaultCachePolicy() CachePolicy {
+	return CachePolicy{
+		ConfigTTL:  DefaultCacheConfigTTL,
+		OperTTL:    DefaultCacheOperTTL,
+		MaxEntries: DefaultCacheMaxEntries,
+	}
+}
+
+// WithCache enables the in-memory response cache using the given policy.
+func WithCache(policy CachePolicy) Option {
+	return func(c *Client) error {
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf("client configuration failed: %w", err)
+		}
+		policy.RouteTTLs = maps.Clone(policy.RouteTTLs)
+		c.cache = newResponseCache(policy)
+		return nil
+	}
+}
+
+// ClearCache drops every cached response. It is a no-op when caching is disabled.
+func (c *Client) ClearCache() {
+	if c == nil || c.cache == nil {
+		return
+	}
+	c.cache.clear()
+}
+
+// validate checks that the cache policy values are usable.
+func (p CachePolicy) validate() error {
+	if p.ConfigTTL < 0 || p.OperTTL < 0 {
+		return errors.New("cache policy validation failed: TTLs must not be negative")
+	}
+	if p.MaxEntries < 0 {
+		return fmt.Errorf("cache policy validation failed: max entries must not be negative, got %d", p.MaxEntries)
+	}
+	for prefix, ttl := range p.RouteTTLs {
+		if prefix == "" || ttl < 0 {
+			return fmt.Errorf("cache policy validation failed: invalid route TTL %q: %v", prefix, ttl)
+		}
+	}
+	return nil
+}
+
+// ttlFor returns the cache lifetime of the data path.
+func (p *CachePolicy) ttlFor(path string) time.Duration {
+	path = dataRoute(path)
+	best, ttl := -1, time.Duration(0)
+	for prefix, routeTTL := range p.RouteTTLs {
+		if strings.HasPrefix(path, dataRoute(prefix)) && len(prefix) > best {
+			best, ttl = len(prefix), routeTTL
+		}
+	}
+	if best >= 0 {
+		return ttl
+	}
+
+	switch module := yangModule(path); {
+	case strings.HasSuffix(module, "-cfg"):
+		return p.ConfigTTL
+	case strings.HasSuffix(module, "-oper"):
+		return p.OperTTL
+	default:
+		return 0
+	}
+}
+
+// cacheEntry is a cached successful response.
+type cacheEntry struct {
+	resp    Response  // Response with Body and Header owned by the cache
+	family  string    // YANG module family of the path
+	expires time.Time // Expiry time
+}
+
+// cacheCall is an in-flight GET shared by concurrent identical requests.
+type cacheCall struct {
+	done chan struct{}
+	resp *Response
+	err  error
+}
+
+// responseCache stores responses keyed by RESTCONF URL and deduplicates concurrent GETs.
+type responseCache struct {
+	mu          sync.Mutex
+	policy      CachePolicy
+	entries     map[string]*cacheEntry
+	calls       map[string]*cacheCall
+	generations map[string]uint64 // Invalidation counter per YANG module family
+	epoch       uint64            // Counter of ClearCache calls
+}
+
+// newResponseCache creates an empty cache.
+func newResponseCache(policy CachePolicy) *responseCache {
+	return &responseCache{
+		policy:      policy,
+		entries:     map[string]*cacheEntry{},
+		calls:       map[string]*cacheCall{},
+		generations: map[string]uint64{},
+	}
+}
+
+// cacheMiddleware serves data GETs from the cache and invalidates it after write calls.
+func (c *Client) cacheMiddleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			if req.Stream {
+				return next.Do(ctx, req)
+			}
+			if req.RPC || req.Method != http.MethodGet {
+				resp, err := next.Do(ctx, req)
+				// Invalidate even on failure since the controller may have applied part of the change
+				if module := yangModule(dataRoute(req.Path)); module != "" {
+					c.cache.invalidate(yangFamily(module))
+				} else {
+					// Datastore-level writes such as YANG Patch may touch any module
+					c.cache.clear()
+				}
+				return resp, err
+			}
+
+			// Conditional GETs are answered by the controller, which knows the current validators
+			ttl := c.cache.policy.ttlFor(req.Path)
+			if ttl <= 0 || len(req.Header) > 0 {
+				return next.Do(ctx, req)
+			}
+			key := c.rest.WithController(req.Host).BuildDataURL(req.Path)
+			return c.cache.get(ctx, key, yangFamily(yangModule(dataRoute(req.Path))), ttl, c.logger.Debug,
+				func() (*Response, error) { return next.Do(ctx, req) })
+		})
+	}
+}
+
+// get returns the cached response for key, or runs fetch once for all concurrent callers
+// and caches a successful result for ttl.
+func (rc *responseCache) get(
+	ctx context.Context, key, family string, ttl time.Duration, debug func(string, ...any),
+	fetch func() (*Response, error),
+) (*Response, error) {
+	for {
+		rc.mu.Lock()
+		if entry, ok := rc.entries[key]; ok {
+			if time.Now().Before(entry.expires) {
+				rc.mu.Unlock()
+				debug("Serving API response from cache", "url", key)
+				return copyResponse(&entry.resp), nil
+			}
+			delete(rc.entries, key)
+		}
+
+		if call, ok := rc.calls[key]; ok {
+			rc.mu.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			// The leader's cancellation does not apply to this caller, so fetch again
+			if isContextError(call.err) && ctx.Err() == nil {
+				continue
+			}
+			if call.resp == nil {
+				return nil, call.err
+			}
+			return copyResponse(call.resp), call.err
+		}
+
+		call := &cacheCall{done: make(chan struct{})}
+		rc.calls[key] = call
+		generation, epoch := rc.generations[family], rc.epoch
+		rc.mu.Unlock()
+
+		call.resp, call.err = fetch()
+
+		rc.mu.Lock()
+		if rc.calls[key] == call {
+			delete(rc.calls, key)
+		}
+		if call.err == nil && call.resp != nil && rc.generations[family] == generation && rc.epoch == epoch {
+			rc.store(key, &cacheEntry{resp: *copyResponse(call.resp), family: family, expires: time.Now().Add(ttl)})
+		}
+		rc.mu.Unlock()
+		close(call.done)
+
+		if call.resp == nil {
+			return nil, call.err
+		}
+		return copyResponse(call.resp), call.err
+	}
+}
+
+// store adds entry, evicting expired entries and then the one expiring first when full.
+// The caller must hold rc.mu.
+func (rc *responseCache) store(key string, entry *cacheEntry) {
+	if rc.policy.MaxEntries > 0 && len(rc.entries) >= rc.policy.MaxEntries {
+		now := time.Now()
+		for k, e := range rc.entries {
+			if !now.Before(e.expires) {
+				delete(rc.entries, k)
+			}
+		}
+		if len(rc.entries) >= rc.policy.MaxEntries {
+			var oldest string
+			for k, e := range rc.entries {
+				if oldest == "" || e.expires.Before(rc.entries[oldest].expires) {
+					oldest = k
+				}
+			}
+			delete(rc.entries, oldest)
+		}
+	}
+	rc.entries[key] = entry
+}
+
+// invalidate drops the cached responses of a YANG module family and detaches in-flight GETs,
+// so that responses fetched before the change are not cached.
+func (rc *responseCache) invalidate(family string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.generations[family]++
+	for key, entry := range rc.entries {
+		if entry.family == family {
+			delete(rc.entries, key)
+		}
+	}
+	for key := range rc.calls {
+		if yangFamily(yangModule(urlRoute(key))) == family {
+			delete(rc.calls, key)
+		}
+	}
+}
+
+// clear drops every cached response.
+func (rc *responseCache) clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.epoch++
+	clear(rc.entries)
+	clear(rc.calls)
+}
+
+// copyResponse returns a copy of resp whose body and headers can be modified by the caller.
+func copyResponse(resp *Response) *Response {
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: bytes.Clone(resp.Body)}
+}
+
+// isContextError reports whether err is a context cancellation or deadline error.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// dataRoute strips the RESTCONF base path, leading slash and query string from path.
+func dataRoute(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	for _, base := range []string{routes.RESTCONFDataPath, routes.RESTCONFOperationsPath} {
+		path = strings.TrimPrefix(path, base)
+	}
+	return strings.TrimPrefix(path, "/")
+}
+
+// urlRoute returns the data route of a full RESTCONF URL.
+func urlRoute(rawURL string) string {
+	for _, base := range []string{routes.RESTCONFDataPath, routes.RESTCONFOperationsPath} {
+		if i := strings.Index(rawURL, base); i >= 0 {
+			return dataRoute(rawURL[i:])
+		}
+	}
+	return dataRoute(rawURL)
+}
+
+// yangModule returns the YANG module name of a route such as "Cisco-IOS-XE-wireless-wlan-cfg".
+func yangModule(route string) string {
+	module, _, _ := strings.Cut(route, ":")
+	return module
+}
+
+// yangFamily returns the module name without its -cfg, -oper, -global-oper or -rpc suffix,
+// so that writes and RPCs invalidate the configuration and operational data of the same feature.
+func yangFamily(module string) string {
+	for _, suffix := range []string{"-global-oper", "-oper", "-cfg-rpc", "-cmd-rpc", "-rpc", "-cfg"} {
+		if trimmed, ok := strings.CutSuffix(module, suffix); ok {
+			return trimmed
+		}
+	}
+	return module
+}
@@ -0,0 +1,242 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+const (
+	cacheTestWLANCfgPath  = "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries"
+	cacheTestWLANOperPath = "/restconf/data/Cisco-IOS-XE-wireless-wlan-global-oper:wlan-global-oper-data"
+	cacheTestAPOperPath   = "/restconf/data/Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data"
+	cacheTestAPRPCPath    = "/restconf/operations/Cisco-IOS-XE-wireless-access-point-cmd-rpc:set-ap-admin-state"
+)
+
+// newCacheTestClient returns a client with caching enabled and a server counting GETs per path.
+func newCacheTestClient(t *testing.T, policy CachePolicy, delay time.Duration) (*Client, *sync.Map) {
+	t.Helper()
+	var calls sync.Map
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			counter, _ := calls.LoadOrStore(r.URL.RequestURI(), new(atomic.Int32))
+			counter.(*atomic.Int32).Add(1)
+		}
+		time.Sleep(delay)
+		w.Write([]byte(`{"result":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithCache(policy))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+	return client, &calls
+}
+
+// cacheTestCalls returns the number of GETs the server received for uri.
+func cacheTestCalls(calls *sync.Map, uri string) int {
+	counter, ok := calls.Load(uri)
+	if !ok {
+		return 0
+	}
+	return int(counter.(*atomic.Int32).Load())
+}
+
+// TestCoreCacheUnit_Options_Validation tests cache policy validation.
+func TestCoreCacheUnit_Options_Validation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mutate    func(p *CachePolicy)
+		expectErr bool
+	}{
+		{"Default", func(p *CachePolicy) {}, false},
+		{"RouteTTL", func(p *CachePolicy) { p.RouteTTLs = map[string]time.Duration{cacheTestAPOperPath: 0} }, false},
+		{"NegativeTTL", func(p *CachePolicy) { p.OperTTL = -time.Second }, true},
+		{"NegativeMaxEntries", func(p *CachePolicy) { p.MaxEntries = -1 }, true},
+		{"EmptyRoutePrefix", func(p *CachePolicy) { p.RouteTTLs = map[string]time.Duration{"": time.Second} }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := DefaultCachePolicy()
+			tc.mutate(&policy)
+			_, err := New("test.example.com", "token", WithCache(policy))
+			if tc.expectErr {
+				testutil.AssertClientCreationError(t, err, tc.name)
+			} else {
+				testutil.AssertNoError(t, err, tc.name)
+			}
+		})
+	}
+}
+
+// TestCoreCacheUnit_TTLFor tests route TTL selection.
+func TestCoreCacheUnit_TTLFor(t *testing.T) {
+	policy := CachePolicy{
+		ConfigTTL: time.Minute,
+		OperTTL:   time.Second,
+		RouteTTLs: map[string]time.Duration{
+			cacheTestAPOperPath:                   0,
+			cacheTestAPOperPath + "/capwap-data":  time.Hour,
+			"Cisco-IOS-XE-wireless-rogue-oper:ro": 3 * time.Second,
+		},
+	}
+
+	testCases := []struct {
+		path string
+		want time.Duration
+	}{
+		{cacheTestWLANCfgPath, time.Minute},
+		{cacheTestWLANOperPath + "?depth=2", time.Second},
+		{cacheTestAPOperPath + "/radio-oper-data", 0},
+		{cacheTestAPOperPath + "/capwap-data", time.Hour},
+		{"Cisco-IOS-XE-wireless-rogue-oper:rogue-oper-data", 3 * time.Second},
+		{"/restconf/data/ietf-yang-library:modules-state", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			testutil.AssertDurationEquals(t, policy.ttlFor(tc.path), tc.want, "ttlFor()")
+		})
+	}
+}
+
+// TestCoreCacheUnit_YANGFamily tests the grouping of modules used for invalidation.
+func TestCoreCacheUnit_YANGFamily(t *testing.T) {
+	testCases := map[string]string{
+		"Cisco-IOS-XE-wireless-wlan-cfg":                       "Cisco-IOS-XE-wireless-wlan",
+		"Cisco-IOS-XE-wireless-wlan-global-oper":               "Cisco-IOS-XE-wireless-wlan",
+		"Cisco-IOS-XE-wireless-access-point-oper":              "Cisco-IOS-XE-wireless-access-point",
+		"Cisco-IOS-XE-wireless-access-point-cmd-rpc":           "Cisco-IOS-XE-wireless-access-point",
+		"Cisco-IOS-XE-wireless-access-point-cfg-rpc":           "Cisco-IOS-XE-wireless-access-point",
+		"Cisco-IOS-XE-wireless-ap-cfg":                         "Cisco-IOS-XE-wireless-ap",
+		"ietf-yang-library":                                    "ietf-yang-library",
+		yangModule(dataRoute(cacheTestWLANCfgPath)):            "Cisco-IOS-XE-wireless-wlan",
+		yangModule(urlRoute("https://h" + cacheTestAPRPCPath)): "Cisco-IOS-XE-wireless-access-point",
+	}
+
+	for module, want := range testCases {
+		testutil.AssertStringEquals(t, yangFamily(module), want, module)
+	}
+}
+
+// TestCoreCacheUnit_Do_HitAndExpiry tests cache hits, per-URL keys and TTL expiry.
+func TestCoreCacheUnit_Do_HitAndExpiry(t *testing.T) {
+	policy := DefaultCachePolicy()
+	policy.OperTTL = 50 * time.Millisecond
+	client, calls := newCacheTestClient(t, policy, 0)
+	ctx := context.Background()
+
+	for range 3 {
+		body, err := client.Do(ctx, http.MethodGet, cacheTestWLANCfgPath)
+		testutil.AssertNoError(t, err, "GET cfg")
+		testutil.AssertStringEquals(t, string(body), `{"result":"ok"}`, "body")
+		body[0] = 'X' // Callers must not be able to corrupt the cached body
+	}
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath), 1, "cfg requests")
+
+	_, err := client.Do(ctx, http.MethodGet, cacheTestWLANCfgPath+"?depth=1")
+	testutil.AssertNoError(t, err, "GET cfg with query")
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath+"?depth=1"), 1, "query is a separate key")
+
+	_, _ = client.Do(ctx, http.MethodGet, cacheTestAPOperPath)
+	_, _ = client.Do(ctx, http.MethodGet, cacheTestAPOperPath)
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestAPOperPath), 1, "oper requests within TTL")
+
+	time.Sleep(60 * time.Millisecond)
+	_, _ = client.Do(ctx, http.MethodGet, cacheTestAPOperPath)
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestAPOperPath), 2, "oper requests after TTL")
+
+	client.ClearCache()
+	_, _ = client.Do(ctx, http.MethodGet, cacheTestWLANCfgPath)
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath), 2, "cfg requests after ClearCache")
+}
+
+// TestCoreCacheUnit_Do_Singleflight tests that concurrent identical GETs share one request.
+func TestCoreCacheUnit_Do_Singleflight(t *testing.T) {
+	client, calls := newCacheTestClient(t, DefaultCachePolicy(), 50*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			body, err := client.Do(context.Background(), http.MethodGet, cacheTestWLANCfgPath)
+			testutil.AssertNoError(t, err, "concurrent GET")
+			testutil.AssertStringEquals(t, string(body), `{"result":"ok"}`, "body")
+		})
+	}
+	wg.Wait()
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath), 1, "requests")
+}
+
+// TestCoreCacheUnit_Do_Invalidation tests that writes and RPCs drop paths of the same module family.
+func TestCoreCacheUnit_Do_Invalidation(t *testing.T) {
+	client, calls := newCacheTestClient(t, DefaultCachePolicy(), 0)
+	ctx := context.Background()
+
+	warm := func() {
+		for _, path := range []string{cacheTestWLANCfgPath, cacheTestWLANOperPath, cacheTestAPOperPath} {
+			_, err := client.Do(ctx, http.MethodGet, path)
+			testutil.AssertNoError(t, err, "GET "+path)
+		}
+	}
+
+	warm()
+	_, err := client.DoWithPayload(ctx, http.MethodPatch, cacheTestWLANCfgPath, map[string]string{})
+	testutil.AssertNoError(t, err, "PATCH")
+	warm()
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath), 2, "wlan cfg after PATCH")
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANOperPath), 2, "wlan oper after PATCH")
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestAPOperPath), 1, "ap oper after PATCH")
+
+	_, err = client.DoRPCWithPayload(ctx, http.MethodPost, cacheTestAPRPCPath, map[string]string{})
+	testutil.AssertNoError(t, err, "RPC")
+	warm()
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath), 2, "wlan cfg after RPC")
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestAPOperPath), 2, "ap oper after RPC")
+
+	// YANG Patch targets the datastore and may touch any module
+	_, err = ApplyYANGPatch(ctx, client, NewYANGPatch("p").Remove(cacheTestAPOperPath))
+	testutil.AssertNoError(t, err, "YANG Patch")
+	warm()
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestWLANCfgPath), 3, "wlan cfg after YANG Patch")
+	testutil.AssertIntEquals(t, cacheTestCalls(calls, cacheTestAPOperPath), 3, "ap oper after YANG Patch")
+}
+
+// TestCoreCacheUnit_Do_ErrorsNotCached tests that failed GETs are not cached.
+func TestCoreCacheUnit_Do_ErrorsNotCached(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(1, http.StatusInternalServerError, &calls)
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithCache(DefaultCachePolicy()))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, cacheTestWLANCfgPath)
+	testutil.AssertError(t, err, "first GET should fail")
+	_, err = client.Do(context.Background(), http.MethodGet, cacheTestWLANCfgPath)
+	testutil.AssertNoError(t, err, "second GET should reach the controller")
+	_, err = client.Do(context.Background(), http.MethodGet, cacheTestWLANCfgPath)
+	testutil.AssertNoError(t, err, "third GET should be cached")
+	testutil.AssertIntEquals(t, int(calls.Load()), 2, "requests")
+}
+
+// TestCoreCacheUnit_MaxEntries tests eviction when the cache is full.
+func TestCoreCacheUnit_MaxEntries(t *testing.T) {
+	cache := newResponseCache(CachePolicy{MaxEntries: 2})
+	now := time.Now()
+	cache.store("a", &cacheEntry{expires: now.Add(time.Minute)})
+	cache.store("b", &cacheEntry{expires: now.Add(time.Second)})
+	cache.store("c", &cacheEntry{expires: now.Add(time.Hour)})
+
+	testutil.AssertIntEquals(t, len(cache.entries), 2, "entries")
+	_, ok := cache.entries["b"]
+	testutil.AssertFalse(t, ok, "entry expiring first should be evicted")
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	ierrors "github.com/umatare5/cisco-ios-xe-wireless-go/internal/errors"
@@ -26,8 +27,19 @@ type Client struct {
 	httpClient     *http.Client              // Reused HTTP client with connection pool
 	rest           *restconf.Builder         // RESTCONF URL builder
 	logger         *slog.Logger              // Structured logger
-	token          string                    // Access token for authorization
+	auth           transport.Authenticator   // Credentials applied to every request
+	session        *transport.SessionJar     // Session cookie jar, nil disables session reuse
 	requestBuilder *transport.RequestBuilder // HTTP request builder
+	retryPolicy    *RetryPolicy              // Retry policy, nil disables retries
+	rateLimiter    *rateLimiter              // Request rate limiter, nil disables throttling
+	inflight       chan struct{}             // Concurrency semaphore, nil means unlimited
+	middlewares    []Middleware              // User middlewares, outermost first
+	doer           Doer                      // Assembled middleware chain
+	tlsSettings    transport.TLSSettings     // TLS parameters of the built-in transport
+	failover       *failoverState            // Prioritized controller addresses and the active one
+	cache          *responseCache            // Response cache, nil disables caching
+	backend        Backend                   // Backend carrying requests, nil uses HTTPS
+	netconfConfig  *NETCONFConfig            // NETCONF settings, nil unless WithNETCONF is applied
 }
 
 // Option represents a functional option for configuring the Client.
@@ -47,10 +59,10 @@ func WithTimeout(timeout time.Duration) Option {
 
 // WithInsecureSkipVerify configures TLS certificate verification.
 func WithInsecureSkipVerify(skip bool) Option {
-	return func(c *Client) error {
-		c.httpClient.Transport = transport.NewTransport(skip)
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		s.InsecureSkipVerify = skip
 		return nil
-	}
+	})
 }
 
 // WithLogger sets a custom logger for the client.
@@ -67,22 +79,52 @@ func WithLogger(logger *slog.Logger) Option {
 // WithUserAgent sets a custom User-Agent header.
 func WithUserAgent(userAgent string) Option {
 	return func(c *Client) error {
-		// This will be handled in the headers when making requests
-		// For now, we store it in the client context (not implemented yet)
+		if strings.TrimSpace(userAgent) == "" {
+			return errors.New("user agent cannot be empty")
+		}
+		c.requestBuilder.SetUserAgent(userAgent)
+		return nil
+	}
+}
+
+// WithHeader sets an extra header sent with every request.
+func WithHeader(key, value string) Option {
+	return WithHeaders(map[string]string{key: value})
+}
+
+// WithHeaders sets extra headers sent with every request, such as correlation IDs or proxy credentials.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) error {
+		for key, value := range headers {
+			if !validation.IsValidHeaderName(key) {
+				return fmt.Errorf("client configuration failed: %w",
+					fmt.Errorf("header validation failed: invalid header name %q", key))
+			}
+			c.requestBuilder.SetHeader(key, value)
+		}
 		return nil
 	}
 }
 
 // New creates a new WNC client with the specified host, token, and options.
 func New(host, token string, opts ...Option) (*Client, error) {
+	if !validation.IsValidAccessToken(token) {
+		return nil, fmt.Errorf("client initialization failed: %w",
+			errors.New("access token validation failed: token is empty or invalid format"))
+	}
+	return NewWithAuthenticator(host, transport.NewBasicTokenAuth(token), opts...)
+}
+
+// NewWithAuthenticator creates a new WNC client that signs requests with the given authenticator.
+func NewWithAuthenticator(host string, auth transport.Authenticator, opts ...Option) (*Client, error) {
 	// Validate inputs using existing validation functions
 	if !validation.IsValidController(host) {
 		return nil, fmt.Errorf("client initialization failed: %w",
 			fmt.Errorf("controller address validation failed: invalid format %s", host))
 	}
-	if !validation.IsValidAccessToken(token) {
+	if auth == nil {
 		return nil, fmt.Errorf("client initialization failed: %w",
-			errors.New("access token validation failed: token is empty or invalid format"))
+			errors.New("authenticator validation failed: authenticator cannot be nil"))
 	}
 
 	// Create HTTP client with transport
@@ -99,11 +141,13 @@ func New(host, token string, opts ...Option) (*Client, error) {
 		httpClient: httpClient,
 		rest:       restBuilder,
 		logger:     slog.Default(),
-		token:      token,
+		auth:       auth,
+		failover:   newFailoverState(host),
 	}
 
 	// Initialize request builder
-	client.requestBuilder = transport.NewRequestBuilder(restBuilder, token, client.logger)
+	client.requestBuilder = transport.NewRequestBuilder(restBuilder, "", client.logger)
+	client.requestBuilder.SetAuthenticator(auth)
 
 	// Apply options
 	for _, opt := range opts {
@@ -112,6 +156,19 @@ func New(host, token string, opts ...Option) (*Client, error) {
 		}
 	}
 
+	// Options may have replaced the HTTP client, so attach the session jar last
+	if client.session != nil {
+		client.httpClient.Jar = client.session
+	}
+	if client.netconfConfig != nil {
+		backend, err := client.newNETCONFBackend()
+		if err != nil {
+			return nil, err
+		}
+		client.backend = backend
+	}
+	client.doer = client.buildChain()
+
 	return client, nil
 }
 
@@ -120,77 +177,125 @@ func (c *Client) Do(ctx context.Context, method, path string) ([]byte, error) {
 	if err := c.validateDoParameters(ctx); err != nil {
 		return nil, err
 	}
+	return c.dispatch(ctx, &Request{Method: method, Path: path})
+}
 
-	req, err := c.requestBuilder.CreateRequest(ctx, method, path)
-	if err != nil {
+// DoWithPayload performs an HTTP request with a payload and returns the response body.
+func (c *Client) DoWithPayload(ctx context.Context, method, path string, payload any) ([]byte, error) {
+	if err := c.validateDoParameters(ctx); err != nil {
 		return nil, err
 	}
+	return c.dispatch(ctx, &Request{Method: method, Path: path, Payload: payload})
+}
 
-	resp, err := c.requestBuilder.ExecuteRequest(c.httpClient, req)
-	if err != nil {
+// DoRPCWithPayload performs an HTTP RPC request with a payload and returns the response body.
+func (c *Client) DoRPCWithPayload(ctx context.Context, method, rpcPath string, payload any) ([]byte, error) {
+	if err := c.validateDoParameters(ctx); err != nil {
 		return nil, err
 	}
-	defer c.closeResponseBody(resp)
+	return c.dispatch(ctx, &Request{Method: method, Path: rpcPath, Payload: payload, RPC: true})
+}
 
-	body, err := c.readResponseBody(resp)
+// DoStream performs a GET request and returns the unread response body, which the caller must close.
+// Error responses are read and returned as *APIError as with Do.
+func (c *Client) DoStream(ctx context.Context, method, path string) (io.ReadCloser, error) {
+	if err := c.validateDoParameters(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := c.doer.Do(ctx, &Request{Method: method, Host: c.failover.activeAddress(), Path: path, Stream: true})
 	if err != nil {
 		return nil, err
 	}
+	return resp.Stream, nil
+}
 
-	// Early return for HTTP errors
-	if err := c.checkHTTPErrors(resp, body); err != nil {
+// dispatch runs req through the middleware chain and returns the response body.
+func (c *Client) dispatch(ctx context.Context, req *Request) ([]byte, error) {
+	resp, err := c.send(ctx, req)
+	if err != nil {
 		return nil, err
 	}
-
-	c.logger.Debug("Successfully processed API response", "path", path)
-	return body, nil
+	return resp.Body, nil
 }
 
-// DoWithPayload performs an HTTP request with a payload and returns the response body.
-func (c *Client) DoWithPayload(ctx context.Context, method, path string, payload any) ([]byte, error) {
+// send runs req against the active controller through the middleware chain.
+func (c *Client) send(ctx context.Context, req *Request) (*Response, error) {
 	if err := c.validateDoParameters(ctx); err != nil {
 		return nil, err
 	}
+	req.Host = c.failover.activeAddress()
+	return c.doer.Do(ctx, req)
+}
 
-	req, err := c.requestBuilder.CreateRequestWithPayload(ctx, method, path, payload)
-	if err != nil {
-		return nil, err
+// roundTrip is the innermost Doer: it builds the HTTP request, waits for throttling
+// and performs a single attempt. Requests are handed to the backend when one is set.
+func (c *Client) roundTrip(ctx context.Context, req *Request) (*Response, error) {
+	if c.backend != nil {
+		return c.backendRoundTrip(ctx, req)
 	}
 
-	resp, err := c.requestBuilder.ExecuteRequest(c.httpClient, req)
+	var (
+		httpReq *http.Request
+		err     error
+	)
+	if req.RPC {
+		httpReq, err = c.requestBuilder.CreateRPCRequestWithPayload(ctx, req.Method, req.Path, req.Payload)
+	} else {
+		httpReq, err = c.requestBuilder.CreateRequestWithPayload(ctx, req.Method, req.Path, req.Payload)
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer c.closeResponseBody(resp)
+	for key, values := range req.Header {
+		httpReq.Header[key] = values
+	}
+	if req.Host != "" && req.Host != httpReq.URL.Host {
+		// Failover selected another controller than the one the URL was built for
+		httpReq.URL.Host = req.Host
+		httpReq.Host = req.Host
+	}
 
-	body, err := c.readResponseBody(resp)
+	release, err := c.acquireSlot(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := c.checkHTTPErrors(resp, body); err != nil {
-		return nil, err
+	resp, err := c.executeOnce(httpReq, req.Stream)
+	if resp != nil && resp.Stream != nil {
+		// Hold the concurrency slot until the caller has finished reading the body
+		resp.Stream = &releasingReadCloser{ReadCloser: resp.Stream, release: release}
+		return resp, nil
 	}
-
-	c.logger.Debug("Successfully processed API response", "path", path)
-	return body, nil
+	release()
+	return resp, err
 }
 
-// DoRPCWithPayload performs an HTTP RPC request with a payload and returns the response body.
-func (c *Client) DoRPCWithPayload(ctx context.Context, method, rpcPath string, payload any) ([]byte, error) {
-	if err := c.validateDoParameters(ctx); err != nil {
-		return nil, err
-	}
-
-	req, err := c.requestBuilder.CreateRPCRequestWithPayload(ctx, method, rpcPath, payload)
+// backendRoundTrip waits for throttling and performs a single attempt over the backend.
+func (c *Client) backendRoundTrip(ctx context.Context, req *Request) (*Response, error) {
+	release, err := c.acquireSlot(ctx)
 	if err != nil {
 		return nil, err
 	}
+	resp, err := c.backend.RoundTrip(ctx, req)
+	if resp != nil && resp.Stream != nil {
+		resp.Stream = &releasingReadCloser{ReadCloser: resp.Stream, release: release}
+		return resp, err
+	}
+	release()
+	return resp, err
+}
 
+// executeOnce performs a single request attempt. For HTTP error statuses both the
+// response and an *APIError are returned so that middlewares can inspect headers.
+// When stream is set, a successful body is returned unread in Response.Stream.
+func (c *Client) executeOnce(req *http.Request, stream bool) (*Response, error) {
 	resp, err := c.requestBuilder.ExecuteRequest(c.httpClient, req)
 	if err != nil {
 		return nil, err
 	}
+	if stream && resp.StatusCode < http.StatusBadRequest {
+		return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Stream: resp.Body}, nil
+	}
 	defer c.closeResponseBody(resp)
 
 	body, err := c.readResponseBody(resp)
@@ -198,12 +303,11 @@ func (c *Client) DoRPCWithPayload(ctx context.Context, method, rpcPath string, p
 		return nil, err
 	}
 
+	out := &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}
 	if err := c.checkHTTPErrors(resp, body); err != nil {
-		return nil, err
+		return out, err
 	}
-
-	c.logger.Debug("Successfully processed RPC response", "rpcPath", rpcPath)
-	return body, nil
+	return out, nil
 }
 
 // validateDoParameters validates input parameters for the Do method.
@@ -228,21 +332,18 @@ func (c *Client) closeResponseBody(resp *http.Response) {
 func (c *Client) readResponseBody(resp *http.Response) ([]byte, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		c.logger.Error("Failed to read response body", "error", err)
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
-
-	c.logger.Debug("Received API response", "status", resp.StatusCode, "content_length", len(body))
 	return body, nil
 }
 
 // checkHTTPErrors validates HTTP status codes and returns appropriate errors.
 func (c *Client) checkHTTPErrors(resp *http.Response, body []byte) error {
 	if resp.StatusCode >= http.StatusBadRequest {
-		c.logger.Error("HTTP error response", "status", resp.StatusCode, "body", string(body))
 		return &APIError{
 			StatusCode: resp.StatusCode,
 			Message:    string(body),
+			Errors:     parseRESTCONFErrors(body),
 			Body:       body,
 		}
 	}
@@ -63,6 +63,41 @@ func TestCoreClientUnit_Options_Success(t *testing.T) {
 		_, err := New(controller, token, WithTimeout(0))
 		testutil.AssertClientCreationError(t, err, "InvalidTimeout")
 	})
+
+	t.Run("EmptyUserAgent", func(t *testing.T) {
+		_, err := New(controller, token, WithUserAgent(" "))
+		testutil.AssertClientCreationError(t, err, "EmptyUserAgent")
+	})
+
+	t.Run("WithHeaders", func(t *testing.T) {
+		client, err := New(controller, token, WithHeaders(map[string]string{"X-Correlation-ID": "abc"}))
+		testutil.AssertClientCreated(t, client, err, "WithHeaders")
+	})
+
+	t.Run("InvalidHeaderName", func(t *testing.T) {
+		_, err := New(controller, token, WithHeader("Bad Header", "value"))
+		testutil.AssertClientCreationError(t, err, "InvalidHeaderName")
+	})
+}
+
+// TestCoreClientUnit_Options_HeadersSent tests that User-Agent and extra headers reach the server.
+func TestCoreClientUnit_Options_HeadersSent(t *testing.T) {
+	var userAgent, correlationID string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		correlationID = r.Header.Get("X-Correlation-ID")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true),
+		WithUserAgent("poller/1.2"), WithHeader("X-Correlation-ID", "job-42"))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET should succeed")
+	testutil.AssertStringEquals(t, userAgent, "poller/1.2", "User-Agent received by server")
+	testutil.AssertStringEquals(t, correlationID, "job-42", "X-Correlation-ID received by server")
 }
 
 // TestCoreClientUnit_DoOperations_Success tests the Do method with mock server.
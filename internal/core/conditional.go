@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	ierrors "github.com/umatare5/cisco-ios-xe-wireless-go/internal/errors"
+)
+
+// Condition sets a conditional request header (RFC 9110 section 13) on a single call.
+type Condition func(http.Header)
+
+// IfMatch makes a write succeed only while the resource still has the given entity tag.
+// The controller answers HTTP 412 otherwise, see IsPreconditionFailed.
+func IfMatch(etag string) Condition {
+	return func(h http.Header) { h.Add("If-Match", etag) }
+}
+
+// IfNoneMatch makes a GET return HTTP 304 Not Modified while the resource has the given entity tag.
+func IfNoneMatch(etag string) Condition {
+	return func(h http.Header) { h.Add("If-None-Match", etag) }
+}
+
+// IfModifiedSince makes a GET return HTTP 304 Not Modified unless the resource changed after t.
+func IfModifiedSince(t time.Time) Condition {
+	return func(h http.Header) { h.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat)) }
+}
+
+// IfUnmodifiedSince makes a write succeed only if the resource has not changed after t.
+func IfUnmodifiedSince(t time.Time) Condition {
+	return func(h http.Header) { h.Set("If-Unmodified-Since", t.UTC().Format(http.TimeFormat)) }
+}
+
+// ResponseMeta holds the validators and status of a RESTCONF response.
+type ResponseMeta struct {
+	StatusCode   int       // HTTP status code
+	ETag         string    // Entity tag of the resource, empty when not sent
+	LastModified time.Time // Last modification time of the resource, zero when not sent
+	NotModified  bool      // True for HTTP 304 responses to conditional GETs
+}
+
+// Conditions returns the conditions that make a later write fail if the resource changed
+// after this response: If-Match for the entity tag, else If-Unmodified-Since.
+func (m *ResponseMeta) Conditions() []Condition {
+	switch {
+	case m == nil:
+		return nil
+	case m.ETag != "":
+		return []Condition{IfMatch(m.ETag)}
+	case !m.LastModified.IsZero():
+		return []Condition{IfUnmodifiedSince(m.LastModified)}
+	default:
+		return nil
+	}
+}
+
+// newResponseMeta extracts the response metadata from resp.
+func newResponseMeta(resp *Response) *ResponseMeta {
+	meta := &ResponseMeta{
+		StatusCode:  resp.StatusCode,
+		ETag:        resp.Header.Get("ETag"),
+		NotModified: resp.StatusCode == http.StatusNotModified,
+	}
+	if lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		meta.LastModified = lastModified
+	}
+	return meta
+}
+
+// conditionHeader builds the request headers of conds, nil when there are none.
+func conditionHeader(conds []Condition) http.Header {
+	if len(conds) == 0 {
+		return nil
+	}
+	header := http.Header{}
+	for _, cond := range conds {
+		if cond != nil {
+			cond(header)
+		}
+	}
+	return header
+}
+
+// GetWithMeta is like Get but also returns the response metadata and accepts conditions
+// such as IfNoneMatch or IfModifiedSince. When the controller answers HTTP 304 Not Modified,
+// the result is nil and meta.NotModified is set.
+func GetWithMeta[T any](
+	ctx context.Context, c *Client, endpoint string, conds []Condition, opts ...QueryOption,
+) (*T, *ResponseMeta, error) {
+	if c == nil {
+		return nil, nil, errors.New(ierrors.ErrClientNil)
+	}
+
+	path, err := c.buildQueryPath(endpoint, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.send(ctx, &Request{Method: http.MethodGet, Path: path, Header: conditionHeader(conds)})
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := newResponseMeta(resp)
+	if meta.NotModified {
+		return nil, meta, nil
+	}
+
+	var out T
+	if len(resp.Body) > 0 {
+		if err := Unmarshal(resp.Body, &out); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return &out, meta, nil
+}
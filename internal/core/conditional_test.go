@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+const conditionalTestPath = "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/policy-list-entries"
+
+// conditionalTestModified is the Last-Modified time served by newConditionalTestServer.
+var conditionalTestModified = time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+// newConditionalTestServer serves a resource with entity tag "v2" that honors conditional headers.
+func newConditionalTestServer(t *testing.T, opts ...Option) *Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v2"`)
+		w.Header().Set("Last-Modified", conditionalTestModified.Format(http.TimeFormat))
+		switch {
+		case r.Header.Get("If-Match") != "" && r.Header.Get("If-Match") != `"v2"`:
+			w.WriteHeader(http.StatusPreconditionFailed)
+		case r.Header.Get("If-None-Match") == `"v2"`:
+			w.WriteHeader(http.StatusNotModified)
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"result":"ok"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		append([]Option{WithInsecureSkipVerify(true)}, opts...)...)
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+	return client
+}
+
+// TestCoreConditionalUnit_GetWithMeta tests metadata extraction and conditional GETs.
+func TestCoreConditionalUnit_GetWithMeta(t *testing.T) {
+	// Conditional GETs must reach the controller even when caching is enabled
+	client := newConditionalTestServer(t, WithCache(DefaultCachePolicy()))
+	ctx := context.Background()
+
+	out, meta, err := GetWithMeta[map[string]string](ctx, client, conditionalTestPath, nil)
+	testutil.AssertNoError(t, err, "GetWithMeta")
+	testutil.AssertStringEquals(t, (*out)["result"], "ok", "decoded body")
+	testutil.AssertStringEquals(t, meta.ETag, `"v2"`, "ETag")
+	testutil.AssertTrue(t, meta.LastModified.Equal(conditionalTestModified), "Last-Modified")
+	testutil.AssertFalse(t, meta.NotModified, "NotModified")
+
+	out, meta, err = GetWithMeta[map[string]string](ctx, client, conditionalTestPath, []Condition{IfNoneMatch(`"v2"`)})
+	testutil.AssertNoError(t, err, "GetWithMeta with If-None-Match")
+	testutil.AssertPointerNil(t, out, "result of 304")
+	testutil.AssertTrue(t, meta.NotModified, "NotModified")
+	testutil.AssertIntEquals(t, meta.StatusCode, StatusNotModified, "status")
+
+	_, meta, err = GetWithMeta[map[string]string](ctx, client, conditionalTestPath, []Condition{IfNoneMatch(`"v1"`)})
+	testutil.AssertNoError(t, err, "GetWithMeta with stale If-None-Match")
+	testutil.AssertFalse(t, meta.NotModified, "NotModified for stale tag")
+}
+
+// TestCoreConditionalUnit_ConditionalWrites tests If-Match on PUT and PATCH.
+func TestCoreConditionalUnit_ConditionalWrites(t *testing.T) {
+	client := newConditionalTestServer(t)
+	ctx := context.Background()
+
+	testutil.AssertNoError(t, PatchVoid(ctx, client, conditionalTestPath, map[string]string{}, IfMatch(`"v2"`)),
+		"PATCH with current tag")
+	testutil.AssertNoError(t, PutVoid(ctx, client, conditionalTestPath, map[string]string{}), "unconditional PUT")
+
+	err := PutVoid(ctx, client, conditionalTestPath, map[string]string{}, IfMatch(`"v1"`))
+	testutil.AssertTrue(t, IsPreconditionFailed(err), "PUT with stale tag should fail with 412")
+	err = PatchVoid(ctx, client, conditionalTestPath, map[string]string{}, IfMatch(`"v1"`))
+	testutil.AssertTrue(t, IsPreconditionFailed(err), "PATCH with stale tag should fail with 412")
+	testutil.AssertFalse(t, IsPreconditionFailed(nil), "nil error")
+}
+
+// TestCoreConditionalUnit_Conditions tests condition headers and validator selection.
+func TestCoreConditionalUnit_Conditions(t *testing.T) {
+	modified := time.Date(2026, time.October, 1, 21, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	header := conditionHeader([]Condition{IfModifiedSince(modified), IfUnmodifiedSince(modified), nil})
+	testutil.AssertStringEquals(t, header.Get("If-Modified-Since"), "Thu, 01 Oct 2026 12:00:00 GMT", "If-Modified-Since")
+	testutil.AssertStringEquals(t, header.Get("If-Unmodified-Since"), "Thu, 01 Oct 2026 12:00:00 GMT",
+		"If-Unmodified-Since")
+	testutil.AssertIntEquals(t, len(conditionHeader(nil)), 0, "no conditions")
+
+	testCases := []struct {
+		name string
+		meta *ResponseMeta
+		want string
+	}{
+		{"ETag", &ResponseMeta{ETag: `"v2"`, LastModified: modified}, "If-Match"},
+		{"LastModified", &ResponseMeta{LastModified: modified}, "If-Unmodified-Since"},
+		{"NoValidators", &ResponseMeta{}, ""},
+		{"NilMeta", nil, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conds := tc.meta.Conditions()
+			if tc.want == "" {
+				testutil.AssertIntEquals(t, len(conds), 0, "conditions")
+				return
+			}
+			header := conditionHeader(conds)
+			testutil.AssertIntEquals(t, len(header), 1, "headers")
+			testutil.AssertStringNotEmpty(t, header.Get(tc.want), tc.want)
+		})
+	}
+}
@@ -2,5 +2,6 @@
 //
 // Contains the primary Client with connection pooling, generic HTTP helpers (Get[T], Post[T], Put[T]),
 // wireless domain types (RadioBand, admin states), and structured error handling (APIError, HTTPError).
+// Every request flows through a Middleware chain that hosts logging, retries and user-supplied hooks.
 // Serves as the central foundation for all service-specific operations via RESTCONF API.
 package core
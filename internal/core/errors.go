@@ -12,6 +12,9 @@ const (
 	// Success status codes.
 	StatusOK = http.StatusOK
 
+	// Redirection status codes.
+	StatusNotModified = http.StatusNotModified
+
 	// Client error status codes.
 	StatusBadRequest          = http.StatusBadRequest
 	StatusUnauthorized        = http.StatusUnauthorized
@@ -19,6 +22,7 @@ const (
 	StatusNotFound            = http.StatusNotFound
 	StatusMethodNotAllowed    = http.StatusMethodNotAllowed
 	StatusConflict            = http.StatusConflict
+	StatusPreconditionFailed  = http.StatusPreconditionFailed
 	StatusUnprocessableEntity = http.StatusUnprocessableEntity
 
 	// Server error status codes.
@@ -53,14 +57,23 @@ func (e *HTTPError) Error() string {
 }
 
 // APIError represents an API-specific error with HTTP status code and message.
+// Errors holds the structured entries when the body is an "ietf-restconf:errors" document.
 type APIError struct {
-	StatusCode int    `json:"status_code"`
-	Message    string `json:"message"`
-	Body       []byte `json:"-"`
+	StatusCode int             `json:"status_code"`
+	Message    string          `json:"message"`
+	Errors     []RESTCONFError `json:"errors,omitempty"`
+	Body       []byte          `json:"-"`
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("API error (HTTP %d): %s", e.StatusCode, e.Message)
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("API error (HTTP %d): %s", e.StatusCode, e.Message)
+	}
+	descriptions := make([]string, len(e.Errors))
+	for i, restconfErr := range e.Errors {
+		descriptions[i] = restconfErr.String()
+	}
+	return fmt.Sprintf("API error (HTTP %d): %s", e.StatusCode, strings.Join(descriptions, "; "))
 }
 
 // IsNotFoundError checks if the error is a 404 not found error.
@@ -84,3 +97,10 @@ func IsNotFoundError(err error) bool {
 		strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "Not Found")
 }
+
+// IsPreconditionFailed reports whether err is an HTTP 412 response to a conditional write,
+// meaning the resource changed since its entity tag or modification time was read.
+func IsPreconditionFailed(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed
+}
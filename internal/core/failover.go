@@ -0,0 +1,333 @@
+package core
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/validation"
+)
+
+// Failover default values.
+const (
+	// DefaultFailbackInterval is how often a more preferred controller is probed after a failover.
+	DefaultFailbackInterval = 5 * time.Minute
+	// DefaultFailbackProbeTimeout bounds each fail-back probe request.
+	DefaultFailbackProbeTimeout = 5 * time.Second
+)
+
+// ControllerState describes the role of a controller address as observed by the client.
+type ControllerState string
+
+// Controller states.
+const (
+	// ControllerActive is the address currently receiving requests.
+	ControllerActive ControllerState = "active"
+	// ControllerStandby is a reachable or untested address that is not in use.
+	ControllerStandby ControllerState = "standby"
+	// ControllerUnreachable is an address whose last request failed with a failover error.
+	ControllerUnreachable ControllerState = "unreachable"
+)
+
+// ControllerStatus reports the redundancy state of one controller address.
+type ControllerStatus struct {
+	Address    string          // Controller address
+	Priority   int             // Position in the preference list, 0 is most preferred
+	State      ControllerState // Observed state
+	LastError  error           // Error that marked the address unreachable, nil otherwise
+	LastChange time.Time       // Time of the last state change, zero if never changed
+}
+
+// failoverState tracks the prioritized controller addresses and the active one.
+type failoverState struct {
+	mu           sync.Mutex
+	controllers  []ControllerStatus // Addresses in priority order
+	active       int                // Index of the active address
+	interval     time.Duration      // Minimum time between fail-back probes
+	probeTimeout time.Duration      // Timeout of each fail-back probe
+	lastProbe    time.Time          // Time of the last fail-back probe round
+	probing      bool               // A fail-back probe round is in progress
+}
+
+// newFailoverState creates the state for a single primary controller.
+func newFailoverState(primary string) *failoverState {
+	return &failoverState{
+		controllers:  []ControllerStatus{{Address: primary, State: ControllerActive}},
+		interval:     DefaultFailbackInterval,
+		probeTimeout: DefaultFailbackProbeTimeout,
+	}
+}
+
+// WithFailoverAddresses adds controller addresses used when the primary host is unreachable,
+// in order of preference. Unreachable or standby controllers (connection errors, timeouts or
+// HTTP 503) trigger a failover to the next address; the client periodically fails back to more
+// preferred addresses. Typical uses are SSO pairs with separate management addresses and N+1 setups.
+func WithFailoverAddresses(addresses ...string) Option {
+	return func(c *Client) error {
+		for _, address := range addresses {
+			if !validation.IsValidController(address) {
+				return fmt.Errorf("client configuration failed: %w",
+					fmt.Errorf("controller address validation failed: invalid format %s", address))
+			}
+			if slices.ContainsFunc(c.failover.controllers, func(s ControllerStatus) bool {
+				return s.Address == address
+			}) {
+				return fmt.Errorf("client configuration failed: %w",
+					fmt.Errorf("controller address validation failed: duplicate address %s", address))
+			}
+			c.failover.controllers = append(c.failover.controllers, ControllerStatus{
+				Address:  address,
+				Priority: len(c.failover.controllers),
+				State:    ControllerStandby,
+			})
+		}
+		return nil
+	}
+}
+
+// WithFailbackInterval sets how often a more preferred controller is probed after a failover.
+func WithFailbackInterval(interval time.Duration) Option {
+	return func(c *Client) error {
+		if interval <= 0 {
+			return fmt.Errorf("client configuration failed: %w",
+				fmt.Errorf("failback interval validation failed: interval must be positive, got %v", interval))
+		}
+		c.failover.interval = interval
+		return nil
+	}
+}
+
+// ActiveController returns the controller address currently receiving requests.
+func (c *Client) ActiveController() string {
+	if c == nil {
+		return ""
+	}
+	return c.failover.activeAddress()
+}
+
+// Redundancy returns the observed state of every controller address in priority order.
+func (c *Client) Redundancy() []ControllerStatus {
+	if c == nil {
+		return nil
+	}
+	c.failover.mu.Lock()
+	defer c.failover.mu.Unlock()
+	return slices.Clone(c.failover.controllers)
+}
+
+// failoverMiddleware sends each call to the active controller and fails over to the next
+// address when it is unreachable. Idempotent calls are repeated on the new controller;
+// other calls are repeated only when the connection could not be established.
+func (c *Client) failoverMiddleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			c.failback(ctx)
+
+			for tried := 1; ; tried++ {
+				attempt := *req
+				attempt.Host = c.failover.activeAddress()
+				resp, err := next.Do(ctx, &attempt)
+				if err == nil || ctx.Err() != nil || !isFailoverError(err) {
+					return resp, err
+				}
+
+				nextHost, switched := c.failover.failFrom(attempt.Host, err)
+				if !switched {
+					return resp, err
+				}
+				c.logger.Warn("Controller unreachable, failing over",
+					"from", attempt.Host, "to", nextHost, "error", err)
+				if tried >= c.failover.size() || !canRepeatOnFailover(req, err) {
+					return resp, err
+				}
+			}
+		})
+	}
+}
+
+// failback probes more preferred controllers once per interval and switches back to the
+// first healthy one.
+func (c *Client) failback(ctx context.Context) {
+	candidates, ok := c.failover.startProbe()
+	if !ok {
+		return
+	}
+	defer c.failover.endProbe()
+
+	for _, address := range candidates {
+		if err := c.probe(ctx, address); err != nil {
+			c.logger.Debug("Fail-back probe failed", "controller", address, "error", err)
+			continue
+		}
+		if c.failover.activate(address) {
+			c.logger.Info("Failing back to preferred controller", "controller", address)
+		}
+		return
+	}
+}
+
+// probe checks that address serves RESTCONF by requesting the root discovery document,
+// or asks the backend when one is set.
+func (c *Client) probe(ctx context.Context, address string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.failover.probeTimeout)
+	defer cancel()
+	if c.backend != nil {
+		return c.backend.Probe(ctx, address)
+	}
+
+	probeURL := c.rest.WithController(address).BaseURL() + routes.WellKnownHostMetaPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probeURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer c.closeResponseBody(resp)
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &APIError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	}
+	return nil
+}
+
+// activeAddress returns the address currently receiving requests.
+func (f *failoverState) activeAddress() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.controllers[f.active].Address
+}
+
+// size returns the number of controller addresses.
+func (f *failoverState) size() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.controllers)
+}
+
+// failFrom marks address unreachable and, when it is still active, activates the next address.
+// It returns the now active address and whether another address is available.
+func (f *failoverState) failFrom(address string, err error) (string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.controllers) < 2 {
+		return address, false
+	}
+	now := time.Now()
+	for i := range f.controllers {
+		if f.controllers[i].Address == address {
+			f.controllers[i].State = ControllerUnreachable
+			f.controllers[i].LastError = err
+			f.controllers[i].LastChange = now
+		}
+	}
+	if f.controllers[f.active].Address == address {
+		f.active = (f.active + 1) % len(f.controllers)
+		f.controllers[f.active].State = ControllerActive
+		f.controllers[f.active].LastError = nil
+		f.controllers[f.active].LastChange = now
+		// Give the new controller a full interval before probing for fail-back
+		f.lastProbe = now
+	}
+	return f.controllers[f.active].Address, true
+}
+
+// activate makes address the active controller. It reports whether the active address changed.
+func (f *failoverState) activate(address string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := range f.controllers {
+		if f.controllers[i].Address != address || i == f.active {
+			continue
+		}
+		now := time.Now()
+		if f.controllers[f.active].State == ControllerActive {
+			f.controllers[f.active].State = ControllerStandby
+			f.controllers[f.active].LastChange = now
+		}
+		f.active = i
+		f.controllers[i].State = ControllerActive
+		f.controllers[i].LastError = nil
+		f.controllers[i].LastChange = now
+		return true
+	}
+	return false
+}
+
+// startProbe returns the addresses preferred over the active one when a fail-back probe is due.
+func (f *failoverState) startProbe() ([]string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.active == 0 || f.probing || time.Since(f.lastProbe) < f.interval {
+		return nil, false
+	}
+	f.probing = true
+	f.lastProbe = time.Now()
+
+	candidates := make([]string, f.active)
+	for i := range f.active {
+		candidates[i] = f.controllers[i].Address
+	}
+	return candidates, true
+}
+
+// endProbe marks the fail-back probe round as finished.
+func (f *failoverState) endProbe() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.probing = false
+}
+
+// isFailoverError reports whether err indicates an unreachable or standby controller.
+func isFailoverError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, ErrRequestTimeout) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == StatusServiceUnavailable
+	}
+	// Certificate problems are configuration errors, not controller failures
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &certErr) {
+		return false
+	}
+	var urlErr *url.Error
+	var netErr net.Error
+	return errors.As(err, &urlErr) || errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// canRepeatOnFailover reports whether req may be sent again to another controller after err.
+// Non-idempotent calls are repeated only when the request never reached the failed controller.
+func canRepeatOnFailover(req *Request, err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		// A standby controller rejects the call without processing it
+		return true
+	}
+	if !req.RPC {
+		switch req.Method {
+		case http.MethodGet, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
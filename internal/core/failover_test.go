@@ -0,0 +1,189 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// failoverTestServer is a controller whose availability can be toggled.
+type failoverTestServer struct {
+	*httptest.Server
+	down  atomic.Bool  // Reply 503 to every request while set
+	calls atomic.Int32 // Number of data requests served
+}
+
+// newFailoverTestServer starts a controller replying with body.
+func newFailoverTestServer(t *testing.T, body string) *failoverTestServer {
+	t.Helper()
+	s := &failoverTestServer{}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == routes.WellKnownHostMetaPath {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		s.calls.Add(1)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// host returns the server address without scheme.
+func (s *failoverTestServer) host() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// closedAddress returns the address of a server that has been shut down.
+func closedAddress(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	address := strings.TrimPrefix(server.URL, "https://")
+	server.Close()
+	return address
+}
+
+// TestCoreFailoverUnit_Do_UnreachablePrimary tests failover on connection errors.
+func TestCoreFailoverUnit_Do_UnreachablePrimary(t *testing.T) {
+	primary := closedAddress(t)
+	secondary := newFailoverTestServer(t, `{"ok":true}`)
+
+	client, err := New(primary, "token", WithInsecureSkipVerify(true),
+		WithFailoverAddresses(secondary.host()), WithLogger(slog.New(slog.DiscardHandler)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+	testutil.AssertStringEquals(t, client.ActiveController(), primary, "initial active controller")
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		_, err = client.DoWithPayload(context.Background(), method, "/restconf/data/test", map[string]string{})
+		testutil.AssertNoError(t, err, method+" should fail over to the secondary")
+	}
+	testutil.AssertIntEquals(t, int(secondary.calls.Load()), 2, "secondary calls")
+	testutil.AssertStringEquals(t, client.ActiveController(), secondary.host(), "active controller")
+
+	status := client.Redundancy()
+	testutil.AssertIntEquals(t, len(status), 2, "redundancy entries")
+	testutil.AssertStringEquals(t, string(status[0].State), string(ControllerUnreachable), "primary state")
+	testutil.AssertNotNil(t, status[0].LastError, "primary error")
+	testutil.AssertStringEquals(t, string(status[1].State), string(ControllerActive), "secondary state")
+	testutil.AssertIntEquals(t, status[1].Priority, 1, "secondary priority")
+}
+
+// TestCoreFailoverUnit_Do_StandbyAndFailback tests failover on HTTP 503 and periodic fail-back.
+func TestCoreFailoverUnit_Do_StandbyAndFailback(t *testing.T) {
+	primary := newFailoverTestServer(t, `{}`)
+	secondary := newFailoverTestServer(t, `{}`)
+	primary.down.Store(true)
+
+	client, err := New(primary.host(), "token", WithInsecureSkipVerify(true),
+		WithFailoverAddresses(secondary.host()), WithFailbackInterval(20*time.Millisecond),
+		WithLogger(slog.New(slog.DiscardHandler)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	ctx := context.Background()
+	_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET should fail over from the standby")
+	testutil.AssertStringEquals(t, client.ActiveController(), secondary.host(), "active after failover")
+
+	// Not yet due: the secondary stays active even though the primary recovered
+	primary.down.Store(false)
+	_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET on secondary")
+	testutil.AssertIntEquals(t, int(secondary.calls.Load()), 2, "secondary calls")
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET after fail-back")
+	testutil.AssertStringEquals(t, client.ActiveController(), primary.host(), "active after fail-back")
+	testutil.AssertIntEquals(t, int(primary.calls.Load()), 1, "primary calls")
+	testutil.AssertStringEquals(t, string(client.Redundancy()[1].State), string(ControllerStandby), "secondary state")
+}
+
+// TestCoreFailoverUnit_Do_NonIdempotentNotRepeated tests that a POST whose connection broke
+// after it was sent is not repeated, while later calls use the next controller.
+func TestCoreFailoverUnit_Do_NonIdempotentNotRepeated(t *testing.T) {
+	var primaryCalls atomic.Int32
+	primary := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryCalls.Add(1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	defer primary.Close()
+	secondary := newFailoverTestServer(t, `{}`)
+
+	client, err := New(strings.TrimPrefix(primary.URL, "https://"), "token", WithInsecureSkipVerify(true),
+		WithFailoverAddresses(secondary.host()), WithLogger(slog.New(slog.DiscardHandler)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	ctx := context.Background()
+	_, err = client.DoWithPayload(ctx, http.MethodPost, "/restconf/data/test", map[string]string{})
+	testutil.AssertError(t, err, "POST should surface the broken connection")
+	testutil.AssertIntEquals(t, int(secondary.calls.Load()), 0, "POST must not be repeated")
+
+	_, err = client.DoWithPayload(ctx, http.MethodPost, "/restconf/data/test", map[string]string{})
+	testutil.AssertNoError(t, err, "next POST should use the secondary")
+	testutil.AssertIntEquals(t, int(primaryCalls.Load()), 1, "primary calls")
+}
+
+// TestCoreFailoverUnit_Do_AllUnreachable tests that the last error surfaces when every address fails
+// and that the client wraps around to the primary.
+func TestCoreFailoverUnit_Do_AllUnreachable(t *testing.T) {
+	primary, secondary := closedAddress(t), closedAddress(t)
+	client, err := New(primary, "token", WithInsecureSkipVerify(true),
+		WithFailoverAddresses(secondary), WithLogger(slog.New(slog.DiscardHandler)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertError(t, err, "GET should fail when all controllers are unreachable")
+	testutil.AssertStringEquals(t, client.ActiveController(), primary, "active controller")
+	status := client.Redundancy()
+	testutil.AssertStringEquals(t, string(status[1].State), string(ControllerUnreachable), "secondary state")
+	testutil.AssertNotNil(t, status[1].LastError, "secondary error")
+}
+
+// TestCoreFailoverUnit_Options tests failover option validation.
+func TestCoreFailoverUnit_Options(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opt     Option
+		wantErr bool
+	}{
+		{"valid addresses", WithFailoverAddresses("192.168.1.101", "wnc2.example.com"), false},
+		{"invalid address", WithFailoverAddresses(""), true},
+		{"duplicate primary", WithFailoverAddresses("192.168.1.100"), true},
+		{"duplicate secondary", WithFailoverAddresses("192.168.1.101", "192.168.1.101"), true},
+		{"valid interval", WithFailbackInterval(time.Minute), false},
+		{"zero interval", WithFailbackInterval(0), true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New("192.168.1.100", "token", tt.opt)
+			if tt.wantErr {
+				testutil.AssertError(t, err, "New()")
+				return
+			}
+			testutil.AssertNoError(t, err, "New()")
+			testutil.AssertStringEquals(t, client.ActiveController(), "192.168.1.100", "active controller")
+		})
+	}
+
+	client, err := New("192.168.1.100", "token")
+	testutil.AssertNoError(t, err, "New()")
+	status := client.Redundancy()
+	testutil.AssertIntEquals(t, len(status), 1, "single controller")
+	testutil.AssertStringEquals(t, string(status[0].State), string(ControllerActive), "single controller state")
+}
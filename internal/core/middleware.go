@@ -0,0 +1,142 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Request describes a RESTCONF call passing through the middleware chain.
+type Request struct {
+	Method  string      // HTTP method
+	Host    string      // Controller address the request is sent to
+	Path    string      // RESTCONF data or operations path as passed to Do*
+	Payload any         // Request payload marshaled as JSON, nil for none
+	Header  http.Header // Per-call request headers such as conditional headers, nil for none
+	RPC     bool        // True for RESTCONF operations (RPC) calls
+	Stream  bool        // Return a successful body unread in Response.Stream
+}
+
+// Response describes the controller response to a RESTCONF call.
+type Response struct {
+	StatusCode int           // HTTP status code
+	Header     http.Header   // Response headers
+	Body       []byte        // Raw response body, nil for successful streaming calls
+	Stream     io.ReadCloser // Unread body of successful streaming calls, closed by the caller
+}
+
+// Doer executes a RESTCONF request.
+//
+// For HTTP error statuses implementations return both the Response and an *APIError,
+// so that middlewares can inspect status codes and headers of failed calls.
+type Doer interface {
+	Do(ctx context.Context, req *Request) (*Response, error)
+}
+
+// DoerFunc adapts an ordinary function to the Doer interface.
+type DoerFunc func(ctx context.Context, req *Request) (*Response, error)
+
+// Do calls f(ctx, req).
+func (f DoerFunc) Do(ctx context.Context, req *Request) (*Response, error) {
+	return f(ctx, req)
+}
+
+// Middleware wraps a Doer to add behavior such as tracing, metrics or auditing.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware appends middlewares to the client chain. The first middleware is the outermost
+// and sees every Do* call exactly once, before retries and re-authentication.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) error {
+		for _, mw := range middlewares {
+			if mw == nil {
+				return errors.New("middleware cannot be nil")
+			}
+		}
+		c.middlewares = append(c.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithHTTPClient replaces the underlying HTTP client. The client is copied, so options applied
+// afterwards (timeout, TLS) do not modify the caller's instance.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("HTTP client cannot be nil")
+		}
+		clone := *httpClient
+		c.httpClient = &clone
+		return nil
+	}
+}
+
+// WithTransport replaces the HTTP transport (http.RoundTripper) used by the client.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) error {
+		if rt == nil {
+			return errors.New("transport cannot be nil")
+		}
+		c.httpClient.Transport = rt
+		return nil
+	}
+}
+
+// buildChain assembles the middleware chain. From outermost to innermost:
+// user middlewares, cache, logging, retry, failover, re-authentication and the HTTP round trip.
+func (c *Client) buildChain() Doer {
+	var chain []Middleware
+	if c.cache != nil {
+		chain = append(chain, c.cacheMiddleware())
+	}
+	chain = append(chain, loggingMiddleware(c.logger))
+	if c.retryPolicy != nil {
+		chain = append(chain, retryMiddleware(c.retryPolicy, c.logger))
+	}
+	if c.failover.size() > 1 {
+		chain = append(chain, c.failoverMiddleware())
+	}
+	chain = append(chain, c.reauthMiddleware())
+	return Chain(DoerFunc(c.roundTrip), slices.Concat(c.middlewares, chain)...)
+}
+
+// Chain wraps doer with middlewares so that the first middleware is the outermost.
+func Chain(doer Doer, middlewares ...Middleware) Doer {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		doer = middlewares[i](doer)
+	}
+	return doer
+}
+
+// loggingMiddleware emits structured debug and error logs for each call.
+func loggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next.Do(ctx, req)
+			latency := time.Since(start)
+
+			if err != nil {
+				attrs := []any{"method", req.Method, "path", req.Path, "latency", latency, "error", err}
+				if resp != nil {
+					attrs = append(attrs, "status", resp.StatusCode, "body", string(resp.Body))
+				}
+				logger.Error("API request failed", attrs...)
+				return resp, err
+			}
+
+			logger.Debug("Received API response",
+				"status", resp.StatusCode, "content_length", len(resp.Body), "latency", latency)
+			if req.RPC {
+				logger.Debug("Successfully processed RPC response", "rpcPath", req.Path)
+			} else {
+				logger.Debug("Successfully processed API response", "path", req.Path)
+			}
+			return resp, nil
+		})
+	}
+}
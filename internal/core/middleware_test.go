@@ -0,0 +1,183 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// recordedCall captures what a middleware observed for one call.
+type recordedCall struct {
+	method  string
+	path    string
+	payload any
+	rpc     bool
+	status  int
+	latency time.Duration
+	err     error
+}
+
+// recordingMiddleware returns a middleware appending every observed call to calls.
+func recordingMiddleware(calls *[]recordedCall) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next.Do(ctx, req)
+			call := recordedCall{
+				method: req.Method, path: req.Path, payload: req.Payload, rpc: req.RPC,
+				latency: time.Since(start), err: err,
+			}
+			if resp != nil {
+				call.status = resp.StatusCode
+			}
+			*calls = append(*calls, call)
+			return resp, err
+		})
+	}
+}
+
+// TestCoreMiddlewareUnit_Chain_Order tests that the first middleware is the outermost.
+func TestCoreMiddlewareUnit_Chain_Order(t *testing.T) {
+	var order []string
+	tag := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+				order = append(order, name)
+				return next.Do(ctx, req)
+			})
+		}
+	}
+
+	doer := Chain(DoerFunc(func(context.Context, *Request) (*Response, error) {
+		order = append(order, "terminal")
+		return &Response{StatusCode: http.StatusOK}, nil
+	}), tag("outer"), tag("inner"))
+
+	_, err := doer.Do(context.Background(), &Request{Method: http.MethodGet})
+	testutil.AssertNoError(t, err, "Chain.Do")
+	testutil.AssertStringEquals(t, strings.Join(order, ","), "outer,inner,terminal", "middleware order")
+}
+
+// TestCoreMiddlewareUnit_Do_ObservesCalls tests that middlewares see request and response details.
+func TestCoreMiddlewareUnit_Do_ObservesCalls(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var calls []recordedCall
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithMiddleware(recordingMiddleware(&calls)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	ctx := context.Background()
+	payload := map[string]string{"k": "v"}
+	_, _ = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	_, _ = client.DoWithPayload(ctx, http.MethodPut, "/restconf/data/test", payload)
+	_, _ = client.DoRPCWithPayload(ctx, http.MethodPost, "/test-rpc", payload)
+	_, _ = client.Do(ctx, http.MethodGet, "/restconf/data/missing")
+
+	testutil.AssertIntEquals(t, len(calls), 4, "observed calls")
+	testutil.AssertStringEquals(t, calls[0].method, http.MethodGet, "GET method")
+	testutil.AssertStringEquals(t, calls[0].path, "/restconf/data/test", "GET path")
+	testutil.AssertIntEquals(t, calls[0].status, http.StatusOK, "GET status")
+	testutil.AssertTrue(t, calls[0].latency > 0, "GET latency")
+	testutil.AssertNotNil(t, calls[1].payload, "PUT payload")
+	testutil.AssertTrue(t, calls[2].rpc, "RPC flag")
+	testutil.AssertIntEquals(t, calls[3].status, http.StatusNotFound, "error status")
+
+	var apiErr *APIError
+	testutil.AssertTrue(t, errors.As(calls[3].err, &apiErr), "error should be APIError")
+}
+
+// TestCoreMiddlewareUnit_Do_SeesCallOnceWithRetry tests that user middlewares wrap the retry loop.
+func TestCoreMiddlewareUnit_Do_SeesCallOnceWithRetry(t *testing.T) {
+	var attempts atomic.Int32
+	server := newFlakyServer(1, http.StatusServiceUnavailable, &attempts)
+	defer server.Close()
+
+	var calls []recordedCall
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithRetryPolicy(newRetryTestPolicy(3)),
+		WithMiddleware(recordingMiddleware(&calls)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET should succeed after retry")
+	testutil.AssertIntEquals(t, int(attempts.Load()), 2, "server attempts")
+	testutil.AssertIntEquals(t, len(calls), 1, "middleware observations")
+}
+
+// TestCoreMiddlewareUnit_Do_ShortCircuit tests that a middleware can answer without the network.
+func TestCoreMiddlewareUnit_Do_ShortCircuit(t *testing.T) {
+	stub := func(Doer) Doer {
+		return DoerFunc(func(context.Context, *Request) (*Response, error) {
+			return &Response{StatusCode: http.StatusOK, Body: []byte(`{"stub":true}`)}, nil
+		})
+	}
+
+	client, err := New("unreachable.invalid", "token", WithMiddleware(stub))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	body, err := client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "stubbed GET")
+	testutil.AssertStringEquals(t, string(body), `{"stub":true}`, "stubbed body")
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// TestCoreMiddlewareUnit_Options_HTTPClientAndTransport tests custom HTTP client and transport options.
+func TestCoreMiddlewareUnit_Options_HTTPClientAndTransport(t *testing.T) {
+	var seen atomic.Int32
+	rt := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		seen.Add(1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+			Request:    r,
+		}, nil
+	})
+
+	t.Run("WithTransport", func(t *testing.T) {
+		client, err := New("controller.example.com", "token", WithTransport(rt))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "GET through custom transport")
+	})
+
+	t.Run("WithHTTPClient", func(t *testing.T) {
+		custom := &http.Client{Transport: rt}
+		client, err := New("controller.example.com", "token", WithHTTPClient(custom), WithTimeout(5*time.Second))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "GET through custom HTTP client")
+		testutil.AssertDurationEquals(t, custom.Timeout, 0, "caller's client should not be modified")
+	})
+
+	testutil.AssertIntEquals(t, int(seen.Load()), 2, "custom transport calls")
+
+	t.Run("NilValues", func(t *testing.T) {
+		_, err := New("controller.example.com", "token", WithHTTPClient(nil))
+		testutil.AssertClientCreationError(t, err, "nil HTTP client")
+		_, err = New("controller.example.com", "token", WithTransport(nil))
+		testutil.AssertClientCreationError(t, err, "nil transport")
+		_, err = New("controller.example.com", "token", WithMiddleware(nil))
+		testutil.AssertClientCreationError(t, err, "nil middleware")
+	})
+}
@@ -0,0 +1,240 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/netconf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// Backend carries single RESTCONF request attempts to a controller beneath the middleware chain.
+// The client uses HTTPS unless WithBackend or WithNETCONF selects another backend.
+//
+// Implementations return bodies as RFC 7951 JSON or, with the application/yang-data+xml
+// content type, as XML wrapped in a single root element; Unmarshal decodes both. For HTTP
+// error statuses both the Response and an *APIError are returned, as with Doer.
+type Backend interface {
+	// RoundTrip sends req to the controller at req.Host.
+	RoundTrip(ctx context.Context, req *Request) (*Response, error)
+	// Probe checks that the controller at address accepts calls, for fail-back.
+	Probe(ctx context.Context, address string) error
+}
+
+// WithBackend carries calls over backend instead of HTTPS. Backends implementing io.Closer
+// are closed by Client.Close.
+func WithBackend(backend Backend) Option {
+	return func(c *Client) error {
+		if backend == nil {
+			return errors.New("backend cannot be nil")
+		}
+		c.backend = backend
+		return nil
+	}
+}
+
+// NETCONFConfig configures the NETCONF/SSH backend.
+type NETCONFConfig struct {
+	Port            int                 // SSH port of the NETCONF subsystem, 830 when zero
+	HostKeyCallback ssh.HostKeyCallback // Host key verification, see ssh.FixedHostKey and knownhosts
+	Timeout         time.Duration       // Connection setup timeout, 30 seconds when zero
+}
+
+// WithNETCONF carries calls over NETCONF/SSH (RFC 6241, RFC 6242) for controllers with
+// RESTCONF disabled. The same service calls are mapped onto <get>, <get-config>,
+// <edit-config> and RPCs, with typed responses decoded from XML by Unmarshal.
+//
+// The SSH user name and password are taken from the client's Basic authenticator. Without
+// a host key callback, host keys are only accepted together with WithInsecureSkipVerify(true).
+// Conditional requests, YANG Patch and the datastore root are not supported over NETCONF.
+func WithNETCONF(cfg NETCONFConfig) Option {
+	return func(c *Client) error {
+		if cfg.Port < 0 || cfg.Port > 65535 {
+			return fmt.Errorf("client configuration failed: %w",
+				fmt.Errorf("NETCONF port validation failed: invalid port %d", cfg.Port))
+		}
+		c.netconfConfig = &cfg
+		return nil
+	}
+}
+
+// Close releases the connections held by the client's backend, such as NETCONF sessions.
+func (c *Client) Close() error {
+	if c == nil {
+		return nil
+	}
+	if closer, ok := c.backend.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// newNETCONFBackend returns the NETCONF backend configured by WithNETCONF. It is created after
+// all options were applied, since WithInsecureSkipVerify may follow WithNETCONF.
+func (c *Client) newNETCONFBackend() (*netconfBackend, error) {
+	hostKeyCallback := c.netconfConfig.HostKeyCallback
+	if hostKeyCallback == nil {
+		if !c.tlsSettings.InsecureSkipVerify {
+			return nil, fmt.Errorf("client configuration failed: %w",
+				errors.New("NETCONF validation failed: host key callback is required unless verification is skipped"))
+		}
+		hostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint:gosec
+	}
+
+	timeout := c.netconfConfig.Timeout
+	config := func(ctx context.Context) (netconf.Config, error) {
+		username, password, err := c.credentials(ctx)
+		if err != nil {
+			return netconf.Config{}, err
+		}
+		return netconf.Config{
+			Username:        username,
+			Password:        password,
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         timeout,
+		}, nil
+	}
+	return &netconfBackend{backend: netconf.NewBackend(c.netconfConfig.Port, config)}, nil
+}
+
+// credentials extracts the Basic Auth user name and password the authenticator would send.
+func (c *Client) credentials(ctx context.Context) (string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://localhost/", nil)
+	if err != nil {
+		return "", "", err
+	}
+	if err := c.auth.Authenticate(ctx, req); err != nil {
+		return "", "", err
+	}
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return "", "", errors.New("NETCONF requires an authenticator with username and password")
+	}
+	return username, password, nil
+}
+
+// netconfBackend adapts netconf.Backend to the Backend interface.
+type netconfBackend struct {
+	backend *netconf.Backend
+}
+
+// RoundTrip carries req over the NETCONF session to req.Host.
+func (b *netconfBackend) RoundTrip(ctx context.Context, req *Request) (*Response, error) {
+	for _, key := range []string{"If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since"} {
+		if req.Header.Get(key) != "" {
+			return nil, &APIError{
+				StatusCode: http.StatusBadRequest,
+				Message:    "NETCONF error: conditional requests are not supported",
+			}
+		}
+	}
+
+	call := netconf.Call{Method: req.Method, Path: req.Path, RPC: req.RPC}
+	if req.Payload != nil {
+		payload, err := json.Marshal(req.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		call.Payload = payload
+	}
+
+	status, body, err := b.backend.Do(ctx, req.Host, call)
+	if err != nil {
+		return nil, netconfAPIError(err)
+	}
+	resp := &Response{
+		StatusCode: status,
+		Header:     http.Header{transport.HTTPHeaderKeyContentType: {transport.HTTPHeaderValueYANGDataXML}},
+		Body:       body,
+	}
+	if req.Stream {
+		resp.Body, resp.Stream = nil, io.NopCloser(bytes.NewReader(body))
+	}
+	return resp, nil
+}
+
+// Probe checks that a NETCONF session to address can be established.
+func (b *netconfBackend) Probe(ctx context.Context, address string) error {
+	return netconfAPIError(b.backend.Ping(ctx, address))
+}
+
+// Close ends the NETCONF sessions.
+func (b *netconfBackend) Close() error {
+	return b.backend.Close()
+}
+
+// netconfAPIError converts NETCONF errors into *APIError with RESTCONF error entries, so that
+// error-tag helpers, re-authentication and failover work as with RESTCONF.
+func netconfAPIError(err error) error {
+	var ncErr *netconf.Error
+	var authErr *netconf.AuthError
+	switch {
+	case errors.As(err, &ncErr):
+		apiErr := &APIError{StatusCode: ncErr.StatusCode(), Message: ncErr.Error()}
+		for _, rpcErr := range ncErr.Errors {
+			restconfErr := RESTCONFError{
+				Type:    rpcErr.Type,
+				Tag:     rpcErr.Tag,
+				AppTag:  rpcErr.AppTag,
+				Path:    rpcErr.Path,
+				Message: rpcErr.Message,
+			}
+			if rpcErr.Info != "" {
+				restconfErr.Info, _ = json.Marshal(rpcErr.Info)
+			}
+			apiErr.Errors = append(apiErr.Errors, restconfErr)
+		}
+		return apiErr
+	case errors.As(err, &authErr):
+		return &APIError{StatusCode: http.StatusUnauthorized, Message: authErr.Error()}
+	default:
+		return err
+	}
+}
+
+// Unmarshal decodes a response body into out. XML bodies of the NETCONF backend are converted
+// to JSON first, using the type of out as the schema for lists, numbers and booleans.
+func Unmarshal(body []byte, out any) error {
+	if isXMLBody(body) {
+		root, err := netconf.Parse(body)
+		if err != nil {
+			return err
+		}
+		if body, err = netconf.ToJSON(root.Children, reflect.TypeOf(out)); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(body, out)
+}
+
+// UnmarshalMember decodes the top-level member of a response body into out, leaving out
+// unchanged when the member is absent.
+func UnmarshalMember(body []byte, member string, out any) error {
+	target := reflect.ValueOf(out)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return errors.New("unmarshal target must be a non-nil pointer")
+	}
+	// Decode into struct { Member *T `json:"member"` } so that XML bodies get a typed schema
+	document := reflect.New(reflect.StructOf([]reflect.StructField{{
+		Name: "Member",
+		Type: target.Type(),
+		Tag:  reflect.StructTag(`json:"` + member + `"`),
+	}}))
+	document.Elem().Field(0).Set(target)
+	return Unmarshal(body, document.Interface())
+}
+
+// isXMLBody reports whether body is XML rather than JSON, which never starts with '<'.
+func isXMLBody(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '<'
+}
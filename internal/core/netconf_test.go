@@ -0,0 +1,139 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// rfTagEntry mirrors a service struct with a key, a number and a boolean.
+type rfTagEntry struct {
+	TagName  string `json:"tag-name"`
+	Priority int    `json:"priority,omitempty"`
+	Enabled  bool   `json:"enabled,omitempty"`
+}
+
+// rfTagList is the response document of an rf-tag list.
+type rfTagList struct {
+	RFTags []rfTagEntry `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-tag"`
+}
+
+// rfTagsXML is the running configuration served by the stand-in server.
+const rfTagsXML = `<data><rf-cfg-data xmlns="http://cisco.com/ns/yang/Cisco-IOS-XE-wireless-rf-cfg"><rf-tags>` +
+	`<rf-tag><tag-name>t1</tag-name><priority>5</priority><enabled>true</enabled></rf-tag>` +
+	`<rf-tag><tag-name>t2</tag-name></rf-tag>` +
+	`</rf-tags></rf-cfg-data></data>`
+
+// rfTagsPath is the RESTCONF path of the rf-tag list.
+const rfTagsPath = routes.RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags/rf-tag"
+
+// newNETCONFClient returns a client carrying calls to a stand-in server answering with handler.
+func newNETCONFClient(t *testing.T, handler testutil.NETCONFHandler) (*Client, *testutil.NETCONFServer) {
+	t.Helper()
+	server := testutil.NewNETCONFServer(t, handler)
+	client, err := NewWithAuthenticator("127.0.0.1",
+		transport.NewBasicAuth(testutil.NETCONFUsername, testutil.NETCONFPassword),
+		WithNETCONF(NETCONFConfig{Port: server.Port, HostKeyCallback: ssh.InsecureIgnoreHostKey()}))
+	testutil.AssertClientCreated(t, client, err, "NETCONF client")
+	t.Cleanup(func() { _ = client.Close() })
+	return client, server
+}
+
+func TestCoreNETCONFUnit_Options_Validation(t *testing.T) {
+	auth := transport.NewBasicAuth("admin", "pass")
+
+	_, err := NewWithAuthenticator("127.0.0.1", auth, WithNETCONF(NETCONFConfig{Port: 70000}))
+	testutil.AssertClientCreationError(t, err, "invalid port")
+
+	_, err = NewWithAuthenticator("127.0.0.1", auth, WithNETCONF(NETCONFConfig{}))
+	testutil.AssertErrorContains(t, err, "host key callback is required", "missing host key callback")
+
+	client, err := NewWithAuthenticator("127.0.0.1", auth,
+		WithNETCONF(NETCONFConfig{}), WithInsecureSkipVerify(true))
+	testutil.AssertClientCreated(t, client, err, "insecure host keys")
+
+	_, err = NewWithAuthenticator("127.0.0.1", auth, WithBackend(nil))
+	testutil.AssertClientCreationError(t, err, "nil backend")
+}
+
+func TestCoreNETCONFUnit_Get_TypedDecoding(t *testing.T) {
+	client, server := newNETCONFClient(t, func(string) string { return rfTagsXML })
+
+	out, err := Get[rfTagList](context.Background(), client, rfTagsPath)
+	testutil.AssertNoError(t, err, "Get")
+	testutil.AssertIntEquals(t, len(out.RFTags), 2, "entries")
+	testutil.AssertIntEquals(t, out.RFTags[0].Priority, 5, "number leaf")
+	testutil.AssertTrue(t, out.RFTags[0].Enabled, "boolean leaf")
+	testutil.AssertStringContains(t, server.LastRequest(), "<get-config>", "cfg module read with get-config")
+
+	var names []string
+	for entry, err := range Stream[rfTagEntry](context.Background(), client, rfTagsPath) {
+		testutil.AssertNoError(t, err, "Stream")
+		names = append(names, entry.TagName)
+	}
+	testutil.AssertStringEquals(t, strings.Join(names, ","), "t1,t2", "streamed entries")
+
+	_, _, err = GetWithMeta[rfTagList](context.Background(), client, rfTagsPath, []Condition{IfNoneMatch(`"x"`)})
+	testutil.AssertErrorContains(t, err, "conditional requests are not supported", "conditional GET")
+}
+
+func TestCoreNETCONFUnit_WritesAndErrors(t *testing.T) {
+	client, server := newNETCONFClient(t, func(rpc string) string {
+		switch {
+		case strings.Contains(rpc, "<edit-config>") && strings.Contains(rpc, "t9"):
+			return `<rpc-error><error-type>application</error-type><error-tag>data-exists</error-tag>` +
+				`<error-severity>error</error-severity><error-message>exists</error-message></rpc-error>`
+		case strings.Contains(rpc, "<edit-config>"):
+			return "<ok/>"
+		default:
+			return rfTagsXML
+		}
+	})
+	list := routes.RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags"
+
+	err := PostVoid(context.Background(), client, list, rfTagList{RFTags: []rfTagEntry{{TagName: "t3", Priority: 1}}})
+	testutil.AssertNoError(t, err, "PostVoid")
+	testutil.AssertStringContains(t, server.LastRequest(), `nc:operation="create"`, "POST creates")
+	testutil.AssertStringContains(t, server.LastRequest(), "<priority>1</priority>", "payload converted to XML")
+
+	err = Delete(context.Background(), client, rfTagsPath+"=t1")
+	testutil.AssertNoError(t, err, "Delete")
+	testutil.AssertStringContains(t, server.LastRequest(), `nc:operation="delete"`, "DELETE deletes")
+
+	err = PostVoid(context.Background(), client, list, rfTagList{RFTags: []rfTagEntry{{TagName: "t9"}}})
+	testutil.AssertTrue(t, IsDataExists(err), "rpc-error mapped to RESTCONF error tag")
+}
+
+func TestCoreNETCONFUnit_Unauthorized(t *testing.T) {
+	server := testutil.NewNETCONFServer(t, func(string) string { return rfTagsXML })
+	client, err := NewWithAuthenticator("127.0.0.1", transport.NewBasicAuth(testutil.NETCONFUsername, "wrong"),
+		WithNETCONF(NETCONFConfig{Port: server.Port, HostKeyCallback: ssh.InsecureIgnoreHostKey()}))
+	testutil.AssertClientCreated(t, client, err, "NETCONF client")
+
+	_, err = Get[rfTagList](context.Background(), client, rfTagsPath)
+	testutil.AssertTrue(t, isUnauthorized(err), "SSH authentication failure reported as HTTP 401")
+}
+
+func TestCoreNETCONFUnit_Unmarshal(t *testing.T) {
+	var out rfTagList
+	testutil.AssertNoError(t, Unmarshal([]byte(`{"Cisco-IOS-XE-wireless-rf-cfg:rf-tag":[{"tag-name":"j"}]}`), &out),
+		"JSON body")
+	testutil.AssertStringEquals(t, out.RFTags[0].TagName, "j", "JSON decoded")
+
+	var output struct {
+		Result int `json:"result"`
+	}
+	body := `<data xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">` +
+		`<output xmlns="http://cisco.com/ns/yang/Cisco-IOS-XE-wireless-rf-rpc"><result>3</result></output></data>`
+	testutil.AssertNoError(t, UnmarshalMember([]byte(body), "Cisco-IOS-XE-wireless-rf-rpc:output", &output),
+		"XML member")
+	testutil.AssertIntEquals(t, output.Result, 3, "typed XML member")
+
+	testutil.AssertError(t, UnmarshalMember(nil, "x", output), "non-pointer target")
+}
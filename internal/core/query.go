@@ -0,0 +1,63 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf"
+)
+
+// QueryOption sets an RFC 8040 query parameter on a single retrieval call.
+type QueryOption func(*restconf.QueryParams) error
+
+// QueryFields limits the response to the given data nodes, such as "wtp-mac" or "ap-state/ap-admin-state".
+// Multiple calls accumulate selectors.
+func QueryFields(fields ...string) QueryOption {
+	return func(q *restconf.QueryParams) error {
+		q.Fields = append(q.Fields, fields...)
+		return nil
+	}
+}
+
+// QueryDepth limits the number of nested levels returned below the target resource.
+func QueryDepth(depth int) QueryOption {
+	return func(q *restconf.QueryParams) error {
+		if depth < 1 {
+			return fmt.Errorf("query parameter validation failed: depth must be positive, got %d", depth)
+		}
+		q.Depth = depth
+		return nil
+	}
+}
+
+// QueryContent selects configuration, operational or all data using the restconf.Content* values.
+func QueryContent(content string) QueryOption {
+	return func(q *restconf.QueryParams) error {
+		q.Content = content
+		return nil
+	}
+}
+
+// QueryWithDefaults selects how default values are reported using the restconf.WithDefaults* modes.
+func QueryWithDefaults(mode string) QueryOption {
+	return func(q *restconf.QueryParams) error {
+		q.WithDefaults = mode
+		return nil
+	}
+}
+
+// buildQueryPath applies opts and appends the resulting query string to endpoint.
+func (c *Client) buildQueryPath(endpoint string, opts []QueryOption) (string, error) {
+	if len(opts) == 0 {
+		return endpoint, nil
+	}
+	var params restconf.QueryParams
+	for _, opt := range opts {
+		if err := opt(&params); err != nil {
+			return "", err
+		}
+	}
+	if err := params.Validate(); err != nil {
+		return "", err
+	}
+	return c.rest.BuildPathWithQuery(endpoint, params), nil
+}
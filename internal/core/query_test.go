@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// TestCoreQueryUnit_Get_SendsQueryParameters tests that query options reach the controller.
+func TestCoreQueryUnit_Get_SendsQueryParameters(t *testing.T) {
+	var rawQuery string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	type response struct{}
+	_, err = Get[response](context.Background(), client, "/restconf/data/test",
+		QueryFields("name", "wtp-mac"),
+		QueryDepth(2),
+		QueryContent(restconf.ContentNonConfig),
+		QueryWithDefaults(restconf.WithDefaultsTrim))
+	testutil.AssertNoError(t, err, "Get with query options")
+	testutil.AssertStringEquals(t, rawQuery,
+		"content=nonconfig&depth=2&fields=name;wtp-mac&with-defaults=trim", "query string")
+
+	_, err = Get[response](context.Background(), client, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "Get without query options")
+	testutil.AssertStringEquals(t, rawQuery, "", "query string without options")
+}
+
+// TestCoreQueryUnit_Get_InvalidOptions tests that invalid query options fail before sending.
+func TestCoreQueryUnit_Get_InvalidOptions(t *testing.T) {
+	client, err := New("192.168.1.100", "token")
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	testCases := []struct {
+		name string
+		opt  QueryOption
+	}{
+		{"zero depth", QueryDepth(0)},
+		{"unknown content", QueryContent("operational")},
+		{"unknown with-defaults", QueryWithDefaults("all")},
+		{"empty field", QueryFields("")},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			type response struct{}
+			_, err := Get[response](context.Background(), client, "/restconf/data/test", tt.opt)
+			testutil.AssertError(t, err, "Get with invalid query option")
+		})
+	}
+}
@@ -2,7 +2,6 @@ package core
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -14,24 +13,11 @@ import (
 // These functions provide a consistent interface for HTTP operations across all services.
 
 // Get is a generic helper reducing boilerplate in service GET methods.
-func Get[T any](ctx context.Context, c *Client, endpoint string) (*T, error) {
-	if c == nil {
-		return nil, errors.New(ierrors.ErrClientNil)
-	}
-
-	body, err := c.Do(ctx, http.MethodGet, endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	var out T
-	if len(body) > 0 {
-		if err := json.Unmarshal(body, &out); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-		}
-	}
-
-	return &out, nil
+// Query options add RFC 8040 parameters such as fields or depth to the request.
+// Use GetWithMeta to also obtain the ETag and Last-Modified validators.
+func Get[T any](ctx context.Context, c *Client, endpoint string, opts ...QueryOption) (*T, error) {
+	out, _, err := GetWithMeta[T](ctx, c, endpoint, nil, opts...)
+	return out, err
 }
 
 // Post is a generic helper for sending POST requests with payload.
@@ -47,7 +33,7 @@ func Post[T any](ctx context.Context, c *Client, endpoint string, payload any) (
 
 	var out T
 	if len(body) > 0 {
-		if err := json.Unmarshal(body, &out); err != nil {
+		if err := Unmarshal(body, &out); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
@@ -86,7 +72,7 @@ func Put[T any](ctx context.Context, c *Client, endpoint string, payload any) (*
 
 	var out T
 	if len(body) > 0 {
-		if err := json.Unmarshal(body, &out); err != nil {
+		if err := Unmarshal(body, &out); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
@@ -95,11 +81,14 @@ func Put[T any](ctx context.Context, c *Client, endpoint string, payload any) (*
 }
 
 // PutVoid is a generic helper for PUT operations without expecting a response body.
-func PutVoid(ctx context.Context, c *Client, endpoint string, payload any) error {
+// Conditions such as IfMatch make the write fail with HTTP 412 when the resource changed meanwhile.
+func PutVoid(ctx context.Context, c *Client, endpoint string, payload any, conds ...Condition) error {
 	if c == nil {
 		return errors.New(ierrors.ErrClientNil)
 	}
-	_, err := c.DoWithPayload(ctx, http.MethodPut, endpoint, payload)
+	_, err := c.send(ctx, &Request{
+		Method: http.MethodPut, Path: endpoint, Payload: payload, Header: conditionHeader(conds),
+	})
 	return err
 }
 
@@ -116,7 +105,7 @@ func Patch[T any](ctx context.Context, c *Client, endpoint string, payload any)
 
 	var out T
 	if len(body) > 0 {
-		if err := json.Unmarshal(body, &out); err != nil {
+		if err := Unmarshal(body, &out); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
@@ -125,11 +114,14 @@ func Patch[T any](ctx context.Context, c *Client, endpoint string, payload any)
 }
 
 // PatchVoid is a generic helper for PATCH operations without expecting a response body.
-func PatchVoid(ctx context.Context, c *Client, endpoint string, payload any) error {
+// Conditions such as IfMatch make the write fail with HTTP 412 when the resource changed meanwhile.
+func PatchVoid(ctx context.Context, c *Client, endpoint string, payload any, conds ...Condition) error {
 	if c == nil {
 		return errors.New(ierrors.ErrClientNil)
 	}
-	_, err := c.DoWithPayload(ctx, http.MethodPatch, endpoint, payload)
+	_, err := c.send(ctx, &Request{
+		Method: http.MethodPatch, Path: endpoint, Payload: payload, Header: conditionHeader(conds),
+	})
 	return err
 }
 
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RESTCONF error-tag values (RFC 8040 §7).
+const (
+	ErrorTagInUse                 = "in-use"
+	ErrorTagInvalidValue          = "invalid-value"
+	ErrorTagTooBig                = "too-big"
+	ErrorTagMissingAttribute      = "missing-attribute"
+	ErrorTagBadAttribute          = "bad-attribute"
+	ErrorTagUnknownAttribute      = "unknown-attribute"
+	ErrorTagBadElement            = "bad-element"
+	ErrorTagUnknownElement        = "unknown-element"
+	ErrorTagUnknownNamespace      = "unknown-namespace"
+	ErrorTagAccessDenied          = "access-denied"
+	ErrorTagLockDenied            = "lock-denied"
+	ErrorTagResourceDenied        = "resource-denied"
+	ErrorTagRollbackFailed        = "rollback-failed"
+	ErrorTagDataExists            = "data-exists"
+	ErrorTagDataMissing           = "data-missing"
+	ErrorTagOperationNotSupported = "operation-not-supported"
+	ErrorTagOperationFailed       = "operation-failed"
+	ErrorTagMalformedMessage      = "malformed-message"
+)
+
+// RESTCONFError is a single entry of an "ietf-restconf:errors" response body (RFC 8040 §7.1).
+type RESTCONFError struct {
+	Type    string          `json:"error-type"`              // transport, rpc, protocol or application
+	Tag     string          `json:"error-tag"`               // Error condition, one of the ErrorTag* values
+	AppTag  string          `json:"error-app-tag,omitempty"` // Data model or implementation specific condition
+	Path    string          `json:"error-path,omitempty"`    // Instance identifier of the offending node
+	Message string          `json:"error-message,omitempty"` // Human readable description
+	Info    json.RawMessage `json:"error-info,omitempty"`    // Additional implementation specific content
+}
+
+// String returns a compact description such as "data-exists: object already exists (path: /x)".
+func (e RESTCONFError) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.Tag)
+	if e.AppTag != "" {
+		fmt.Fprintf(&sb, " [%s]", e.AppTag)
+	}
+	if e.Message != "" {
+		sb.WriteString(": " + e.Message)
+	}
+	if e.Path != "" {
+		fmt.Fprintf(&sb, " (path: %s)", e.Path)
+	}
+	return sb.String()
+}
+
+// restconfErrorsBody is the JSON encoding of the RESTCONF errors container. Controllers
+// send the module-qualified member; the unqualified one is accepted for robustness.
+type restconfErrorsBody struct {
+	Qualified   *restconfErrorList `json:"ietf-restconf:errors"`
+	Unqualified *restconfErrorList `json:"errors"`
+}
+
+// restconfErrorList holds the error entries of the errors container.
+type restconfErrorList struct {
+	Error []RESTCONFError `json:"error"`
+}
+
+// parseRESTCONFErrors extracts the RESTCONF error entries from a response body.
+// It returns nil when the body is not a RESTCONF errors document.
+func parseRESTCONFErrors(body []byte) []RESTCONFError {
+	if len(body) == 0 {
+		return nil
+	}
+	var doc restconfErrorsBody
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil
+	}
+	switch {
+	case doc.Qualified != nil && len(doc.Qualified.Error) > 0:
+		return doc.Qualified.Error
+	case doc.Unqualified != nil && len(doc.Unqualified.Error) > 0:
+		return doc.Unqualified.Error
+	}
+	return nil
+}
+
+// HasErrorTag reports whether err is an *APIError carrying a RESTCONF error with the given tag.
+func HasErrorTag(err error, tag string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, e := range apiErr.Errors {
+		if e.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDataExists reports whether err indicates that the data to be created already exists.
+func IsDataExists(err error) bool { return HasErrorTag(err, ErrorTagDataExists) }
+
+// IsDataMissing reports whether err indicates that the data to be modified does not exist.
+func IsDataMissing(err error) bool { return HasErrorTag(err, ErrorTagDataMissing) }
+
+// IsLockDenied reports whether err indicates that the datastore is locked by another session.
+func IsLockDenied(err error) bool { return HasErrorTag(err, ErrorTagLockDenied) }
+
+// IsInvalidValue reports whether err indicates that a request value was rejected.
+func IsInvalidValue(err error) bool { return HasErrorTag(err, ErrorTagInvalidValue) }
+
+// IsAccessDenied reports whether err indicates that access control denied the operation.
+func IsAccessDenied(err error) bool { return HasErrorTag(err, ErrorTagAccessDenied) }
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+const dataExistsBody = `{
+  "ietf-restconf:errors": {
+    "error": [
+      {
+        "error-type": "application",
+        "error-tag": "data-exists",
+        "error-path": "/Cisco-IOS-XE-wireless-site-cfg:site-cfg-data/site-tag-configs/site-tag-config",
+        "error-message": "object already exists",
+        "error-info": {"error-number": 1}
+      }
+    ]
+  }
+}`
+
+// TestCoreRESTCONFErrorsUnit_parseRESTCONFErrors tests decoding of RESTCONF error documents.
+func TestCoreRESTCONFErrorsUnit_parseRESTCONFErrors(t *testing.T) {
+	errs := parseRESTCONFErrors([]byte(dataExistsBody))
+	testutil.AssertIntEquals(t, len(errs), 1, "error count")
+	testutil.AssertStringEquals(t, errs[0].Type, "application", "error-type")
+	testutil.AssertStringEquals(t, errs[0].Tag, ErrorTagDataExists, "error-tag")
+	testutil.AssertStringEquals(t, errs[0].Message, "object already exists", "error-message")
+	testutil.AssertStringContains(t, errs[0].Path, "site-tag-config", "error-path")
+	testutil.AssertStringEquals(t, string(errs[0].Info), `{"error-number": 1}`, "error-info")
+
+	testCases := []struct {
+		name  string
+		body  string
+		count int
+	}{
+		{"unqualified member", `{"errors":{"error":[{"error-type":"protocol","error-tag":"lock-denied"}]}}`, 1},
+		{
+			"multiple errors",
+			`{"ietf-restconf:errors":{"error":[{"error-tag":"invalid-value"},{"error-tag":"missing-attribute"}]}}`,
+			2,
+		},
+		{"empty body", ``, 0},
+		{"plain text", `Not Found`, 0},
+		{"other JSON", `{"message":"failed"}`, 0},
+		{"empty error list", `{"ietf-restconf:errors":{"error":[]}}`, 0},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertIntEquals(t, len(parseRESTCONFErrors([]byte(tt.body))), tt.count, "error count")
+		})
+	}
+}
+
+// TestCoreRESTCONFErrorsUnit_Do_StructuredAPIError tests that HTTP errors carry parsed entries.
+func TestCoreRESTCONFErrorsUnit_Do_StructuredAPIError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(dataExistsBody))
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	err = PostVoid(context.Background(), client, "/restconf/data/test", map[string]string{})
+	wrapped := fmt.Errorf("create site tag: %w", err)
+
+	var apiErr *APIError
+	testutil.AssertTrue(t, errors.As(wrapped, &apiErr), "error should be APIError")
+	testutil.AssertIntEquals(t, apiErr.StatusCode, http.StatusConflict, "status code")
+	testutil.AssertIntEquals(t, len(apiErr.Errors), 1, "error count")
+	testutil.AssertStringContains(t, apiErr.Error(), "data-exists: object already exists", "Error()")
+
+	testutil.AssertTrue(t, IsDataExists(wrapped), "IsDataExists")
+	testutil.AssertFalse(t, IsDataMissing(wrapped), "IsDataMissing")
+	testutil.AssertFalse(t, IsLockDenied(wrapped), "IsLockDenied")
+	testutil.AssertFalse(t, IsInvalidValue(wrapped), "IsInvalidValue")
+	testutil.AssertFalse(t, IsAccessDenied(wrapped), "IsAccessDenied")
+}
+
+// TestCoreRESTCONFErrorsUnit_Helpers tests the error-tag helpers.
+func TestCoreRESTCONFErrorsUnit_Helpers(t *testing.T) {
+	apiErr := func(tag string) error {
+		return &APIError{StatusCode: http.StatusBadRequest, Errors: []RESTCONFError{{Type: "protocol", Tag: tag}}}
+	}
+
+	testutil.AssertTrue(t, IsLockDenied(apiErr(ErrorTagLockDenied)), "IsLockDenied")
+	testutil.AssertTrue(t, IsInvalidValue(apiErr(ErrorTagInvalidValue)), "IsInvalidValue")
+	testutil.AssertTrue(t, IsAccessDenied(apiErr(ErrorTagAccessDenied)), "IsAccessDenied")
+	testutil.AssertTrue(t, IsDataMissing(apiErr(ErrorTagDataMissing)), "IsDataMissing")
+	testutil.AssertTrue(t, HasErrorTag(apiErr(ErrorTagInUse), ErrorTagInUse), "HasErrorTag")
+	testutil.AssertFalse(t, IsDataExists(nil), "nil error")
+	testutil.AssertFalse(t, IsDataExists(errors.New("data-exists")), "plain error")
+	testutil.AssertFalse(t, IsDataExists(&APIError{StatusCode: http.StatusConflict}), "APIError without entries")
+}
+
+// TestCoreRESTCONFErrorsUnit_String tests RESTCONFError descriptions.
+func TestCoreRESTCONFErrorsUnit_String(t *testing.T) {
+	full := RESTCONFError{Tag: ErrorTagInvalidValue, AppTag: "range", Message: "out of range", Path: "/a/b"}
+	testutil.AssertStringEquals(t, full.String(), "invalid-value [range]: out of range (path: /a/b)", "full")
+	testutil.AssertStringEquals(t, RESTCONFError{Tag: ErrorTagInUse}.String(), "in-use", "tag only")
+}
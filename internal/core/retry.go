@@ -0,0 +1,220 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Retry policy default values.
+const (
+	// DefaultRetryMaxAttempts is the default number of attempts, including the first one.
+	DefaultRetryMaxAttempts = 3
+	// DefaultRetryInitialBackoff is the delay before the first retry.
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	// DefaultRetryMaxBackoff caps the computed exponential backoff delay.
+	DefaultRetryMaxBackoff = 30 * time.Second
+	// DefaultRetryMultiplier is the exponential growth factor between retries.
+	DefaultRetryMultiplier = 2.0
+	// DefaultRetryJitter is the fraction of each delay that is randomized.
+	DefaultRetryJitter = 0.2
+)
+
+// DefaultRetryableStatusCodes lists HTTP status codes treated as transient by default.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	StatusInternalServerError,
+	StatusBadGateway,
+	StatusServiceUnavailable,
+	StatusGatewayTimeout,
+}
+
+// RetryPolicy configures automatic retries for transient transport errors and HTTP responses.
+//
+// Only idempotent methods (GET, PUT, DELETE) are retried. RPC POSTs are retried only when
+// RetryRPC is set, and plain data POST/PATCH requests are never retried.
+type RetryPolicy struct {
+	MaxAttempts          int           // Total attempts including the first one
+	InitialBackoff       time.Duration // Delay before the first retry
+	MaxBackoff           time.Duration // Upper bound for the exponential backoff delay
+	Multiplier           float64       // Backoff growth factor between attempts
+	Jitter               float64       // Randomized fraction of each delay in range [0, 1]
+	RetryableStatusCodes []int         // HTTP status codes that trigger a retry
+	RetryRPC             bool          // Allow retrying RPC POST operations
+}
+
+// DefaultRetryPolicy returns a retry policy populated with the package defaults.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          DefaultRetryMaxAttempts,
+		InitialBackoff:       DefaultRetryInitialBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		Multiplier:           DefaultRetryMultiplier,
+		Jitter:               DefaultRetryJitter,
+		RetryableStatusCodes: slices.Clone(DefaultRetryableStatusCodes),
+	}
+}
+
+// WithRetryPolicy enables automatic retries using the given policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf("client configuration failed: %w", err)
+		}
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// retryMiddleware retries transient failures according to policy.
+func retryMiddleware(policy *RetryPolicy, logger *slog.Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			maxAttempts := policy.attemptsFor(req.Method, req.RPC)
+
+			for attempt := 1; ; attempt++ {
+				resp, err := next.Do(ctx, req)
+				if err == nil || attempt >= maxAttempts || ctx.Err() != nil || !policy.isRetryable(err) {
+					return resp, err
+				}
+
+				var header http.Header
+				if resp != nil {
+					header = resp.Header
+				}
+				delay := policy.delay(attempt, header)
+				if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+					logger.Warn("Retry abandoned: delay exceeds context deadline",
+						"method", req.Method, "path", req.Path, "attempt", attempt, "delay", delay, "error", err)
+					return resp, err
+				}
+
+				logger.Warn("Retrying API request",
+					"method", req.Method, "path", req.Path, "attempt", attempt, "max_attempts", maxAttempts,
+					"delay", delay, "error", err)
+
+				if waitErr := sleepWithContext(ctx, delay); waitErr != nil {
+					return resp, err
+				}
+			}
+		})
+	}
+}
+
+// validate checks that the retry policy values are usable.
+func (p RetryPolicy) validate() error {
+	switch {
+	case p.MaxAttempts < 1:
+		return fmt.Errorf("retry policy validation failed: max attempts must be at least 1, got %d",
+			p.MaxAttempts)
+	case p.InitialBackoff < 0 || p.MaxBackoff < 0:
+		return errors.New("retry policy validation failed: backoff durations must not be negative")
+	case p.Multiplier < 1:
+		return fmt.Errorf("retry policy validation failed: multiplier must be at least 1, got %v",
+			p.Multiplier)
+	case p.Jitter < 0 || p.Jitter > 1:
+		return fmt.Errorf("retry policy validation failed: jitter must be between 0 and 1, got %v",
+			p.Jitter)
+	}
+	return nil
+}
+
+// attemptsFor returns the number of attempts allowed for the given method.
+func (p *RetryPolicy) attemptsFor(method string, rpc bool) int {
+	if p == nil {
+		return 1
+	}
+	if rpc {
+		if p.RetryRPC {
+			return p.MaxAttempts
+		}
+		return 1
+	}
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return p.MaxAttempts
+	default:
+		return 1
+	}
+}
+
+// isRetryable reports whether err represents a transient failure.
+func (p *RetryPolicy) isRetryable(err error) bool {
+	if p == nil || err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return slices.Contains(p.RetryableStatusCodes, apiErr.StatusCode)
+	}
+	// Transport failures such as connection resets, refusals and timeouts
+	var urlErr *url.Error
+	var netErr net.Error
+	return errors.As(err, &urlErr) || errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// delay returns the wait before the next attempt, honoring the Retry-After header when present.
+func (p *RetryPolicy) delay(attempt int, header http.Header) time.Duration {
+	backoff := float64(p.InitialBackoff)
+	for range attempt - 1 {
+		backoff *= p.Multiplier
+	}
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		backoff -= backoff * p.Jitter * rand.Float64() //nolint:gosec // jitter does not need crypto randomness
+	}
+
+	wait := time.Duration(backoff)
+	if retryAfter, ok := parseRetryAfter(header, time.Now()); ok && retryAfter > wait {
+		wait = retryAfter
+	}
+	return wait
+}
+
+// parseRetryAfter parses a Retry-After header given either as delay-seconds or an HTTP-date.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleepWithContext waits for d or until ctx is done, whichever comes first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
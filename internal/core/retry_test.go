@@ -0,0 +1,220 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// newRetryTestPolicy returns a fast retry policy for unit tests.
+func newRetryTestPolicy(maxAttempts int) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+// newFlakyServer returns a server that fails with status for the first failures requests.
+func newFlakyServer(failures int32, status int, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"result": "ok"}`))
+	}))
+}
+
+// TestCoreRetryUnit_Options_Validation tests retry policy validation.
+func TestCoreRetryUnit_Options_Validation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mutate    func(p *RetryPolicy)
+		expectErr bool
+	}{
+		{"Default", func(p *RetryPolicy) {}, false},
+		{"ZeroAttempts", func(p *RetryPolicy) { p.MaxAttempts = 0 }, true},
+		{"NegativeBackoff", func(p *RetryPolicy) { p.InitialBackoff = -time.Second }, true},
+		{"MultiplierBelowOne", func(p *RetryPolicy) { p.Multiplier = 0.5 }, true},
+		{"JitterAboveOne", func(p *RetryPolicy) { p.Jitter = 1.5 }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := DefaultRetryPolicy()
+			tc.mutate(&policy)
+			_, err := New("test.example.com", "token", WithRetryPolicy(policy))
+			if tc.expectErr {
+				testutil.AssertClientCreationError(t, err, tc.name)
+			} else {
+				testutil.AssertNoError(t, err, tc.name)
+			}
+		})
+	}
+}
+
+// TestCoreRetryUnit_Do_RetriesTransientStatus tests retries on 503 for idempotent methods.
+func TestCoreRetryUnit_Do_RetriesTransientStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(2, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithRetryPolicy(newRetryTestPolicy(3)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	body, err := client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertNoError(t, err, "GET should succeed after retries")
+	testutil.AssertStringContains(t, string(body), "ok", "Response body")
+	testutil.AssertIntEquals(t, int(calls.Load()), 3, "Attempt count")
+}
+
+// TestCoreRetryUnit_Do_ExhaustsAttempts tests that the last error is returned after max attempts.
+func TestCoreRetryUnit_Do_ExhaustsAttempts(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(10, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithRetryPolicy(newRetryTestPolicy(2)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertError(t, err, "GET should fail after exhausting attempts")
+	testutil.AssertIntEquals(t, int(calls.Load()), 2, "Attempt count")
+}
+
+// TestCoreRetryUnit_Do_NonRetryableStatus tests that client errors are not retried.
+func TestCoreRetryUnit_Do_NonRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := newFlakyServer(1, http.StatusBadRequest, &calls)
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithRetryPolicy(newRetryTestPolicy(3)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	_, err = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+	testutil.AssertError(t, err, "GET should fail on 400")
+	testutil.AssertIntEquals(t, int(calls.Load()), 1, "Attempt count")
+}
+
+// TestCoreRetryUnit_MethodSelection tests that only idempotent methods and opted-in RPCs are retried.
+func TestCoreRetryUnit_MethodSelection(t *testing.T) {
+	testCases := []struct {
+		name          string
+		retryRPC      bool
+		call          func(c *Client) error
+		expectedCalls int
+	}{
+		{"PUT", false, func(c *Client) error {
+			return PutVoid(context.Background(), c, "/restconf/data/test", map[string]string{"k": "v"})
+		}, 2},
+		{"DELETE", false, func(c *Client) error {
+			return Delete(context.Background(), c, "/restconf/data/test")
+		}, 2},
+		{"POST", false, func(c *Client) error {
+			return PostVoid(context.Background(), c, "/restconf/data/test", map[string]string{"k": "v"})
+		}, 1},
+		{"PATCH", false, func(c *Client) error {
+			return PatchVoid(context.Background(), c, "/restconf/data/test", map[string]string{"k": "v"})
+		}, 1},
+		{"RPCWithoutOptIn", false, func(c *Client) error {
+			return PostRPCVoid(context.Background(), c, "/test-rpc", map[string]string{"k": "v"})
+		}, 1},
+		{"RPCWithOptIn", true, func(c *Client) error {
+			return PostRPCVoid(context.Background(), c, "/test-rpc", map[string]string{"k": "v"})
+		}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := newFlakyServer(1, http.StatusServiceUnavailable, &calls)
+			defer server.Close()
+
+			policy := newRetryTestPolicy(3)
+			policy.RetryRPC = tc.retryRPC
+			client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+				WithInsecureSkipVerify(true), WithRetryPolicy(policy))
+			testutil.AssertNoError(t, err, "Client creation should succeed")
+
+			_ = tc.call(client)
+			testutil.AssertIntEquals(t, int(calls.Load()), tc.expectedCalls, "Attempt count")
+		})
+	}
+}
+
+// TestCoreRetryUnit_Do_ContextDeadline tests that a Retry-After beyond the deadline stops retrying.
+func TestCoreRetryUnit_Do_ContextDeadline(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithRetryPolicy(newRetryTestPolicy(5)))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	testutil.AssertError(t, err, "GET should fail when Retry-After exceeds deadline")
+	testutil.AssertIntEquals(t, int(calls.Load()), 1, "Attempt count")
+}
+
+// TestCoreRetryUnit_Delay tests backoff computation and Retry-After parsing.
+func TestCoreRetryUnit_Delay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+
+	t.Run("ExponentialBackoff", func(t *testing.T) {
+		testutil.AssertDurationEquals(t, policy.delay(1, nil), 100*time.Millisecond, "attempt 1")
+		testutil.AssertDurationEquals(t, policy.delay(2, nil), 200*time.Millisecond, "attempt 2")
+		testutil.AssertDurationEquals(t, policy.delay(3, nil), 300*time.Millisecond, "attempt 3 capped")
+	})
+
+	t.Run("RetryAfterSeconds", func(t *testing.T) {
+		header := http.Header{"Retry-After": []string{"2"}}
+		testutil.AssertDurationEquals(t, policy.delay(1, header), 2*time.Second, "Retry-After seconds")
+	})
+
+	t.Run("RetryAfterHTTPDate", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		header := http.Header{"Retry-After": []string{now.Add(5 * time.Second).Format(http.TimeFormat)}}
+		wait, ok := parseRetryAfter(header, now)
+		testutil.AssertTrue(t, ok, "Retry-After date should parse")
+		testutil.AssertDurationEquals(t, wait, 5*time.Second, "Retry-After date")
+	})
+
+	t.Run("RetryAfterInvalid", func(t *testing.T) {
+		_, ok := parseRetryAfter(http.Header{"Retry-After": []string{"soon"}}, time.Now())
+		testutil.AssertFalse(t, ok, "Invalid Retry-After should be ignored")
+	})
+
+	t.Run("Jitter", func(t *testing.T) {
+		jittered := policy
+		jittered.Jitter = 0.5
+		for range 20 {
+			d := jittered.delay(1, nil)
+			testutil.AssertTrue(t, d >= 50*time.Millisecond && d <= 100*time.Millisecond, "jitter range")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sync"
+
+	ierrors "github.com/umatare5/cisco-ios-xe-wireless-go/internal/errors"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf"
+)
+
+// Stream performs a GET request and yields the entries of the returned list one at a time,
+// decoding them from the response body without buffering it. It suits large operational
+// lists such as client or rogue tables. The request is sent when iteration starts; after an
+// error is yielded the iteration stops. Breaking out of the loop closes the connection.
+func Stream[T any](ctx context.Context, c *Client, endpoint string, opts ...QueryOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if c == nil {
+			yield(zero, errors.New(ierrors.ErrClientNil))
+			return
+		}
+
+		path, err := c.buildQueryPath(endpoint, opts)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		body, err := c.DoStream(ctx, http.MethodGet, path)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer func() {
+			if closeErr := body.Close(); closeErr != nil {
+				c.logger.Error("Failed to close response body", "error", closeErr)
+			}
+		}()
+
+		reader := bufio.NewReader(body)
+		if first, _ := reader.Peek(1); len(first) == 1 && first[0] == '<' {
+			// XML bodies of the NETCONF backend need the whole document for conversion
+			if err := yieldXMLList(reader, restconf.MemberName(endpoint), yield); err != nil {
+				yield(zero, fmt.Errorf("failed to decode response: %w", err))
+			}
+			return
+		}
+		if err := decodeList(json.NewDecoder(reader), restconf.MemberName(endpoint), yield); err != nil {
+			yield(zero, fmt.Errorf("failed to decode response: %w", err))
+		}
+	}
+}
+
+// errStopped signals that the consumer stopped the iteration.
+var errStopped = errors.New("iteration stopped")
+
+// decodeList decodes the array under member of a JSON object and yields each entry.
+// An empty body yields nothing.
+func decodeList[T any](dec *json.Decoder, member string, yield func(T, error) bool) error {
+	tok, err := dec.Token()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected JSON object, got %v", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key, _ := tok.(string); key != member {
+			if err := skipValue(dec); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := decodeArray(dec, yield); err != nil {
+			if errors.Is(err, errStopped) {
+				return nil
+			}
+			return fmt.Errorf("member %q: %w", member, err)
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// yieldXMLList decodes the list under member of an XML body and yields each entry.
+func yieldXMLList[T any](r io.Reader, member string, yield func(T, error) bool) error {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var items []T
+	if err := UnmarshalMember(body, member, &items); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if !yield(item, nil) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// decodeArray decodes the next JSON array and yields each entry.
+func decodeArray[T any](dec *json.Decoder, yield func(T, error) bool) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("expected JSON array, got %v", tok)
+	}
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return err
+		}
+		if !yield(item, nil) {
+			return errStopped
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// skipValue consumes the next JSON value without decoding it.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// releasingReadCloser releases a concurrency slot once the body is closed.
+type releasingReadCloser struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close closes the body and releases the slot.
+func (r *releasingReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
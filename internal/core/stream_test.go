@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+const streamTestPath = "/restconf/data/Cisco-IOS-XE-wireless-test-oper:test-oper-data/entry"
+
+type streamTestEntry struct {
+	Name string `json:"name"`
+}
+
+// newStreamTestClient returns a client for a server that replies with body and status.
+func newStreamTestClient(t *testing.T, status int, body string, opts ...Option) *Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		append([]Option{WithInsecureSkipVerify(true)}, opts...)...)
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+	return client
+}
+
+// collectStream drains a stream and returns the entry names and the first error.
+func collectStream(client *Client, path string) ([]string, error) {
+	var names []string
+	for entry, err := range Stream[streamTestEntry](context.Background(), client, path) {
+		if err != nil {
+			return names, err
+		}
+		names = append(names, entry.Name)
+	}
+	return names, nil
+}
+
+// TestCoreStreamUnit_Stream_Success tests decoding list entries and skipping other members.
+func TestCoreStreamUnit_Stream_Success(t *testing.T) {
+	body := `{"other:member":{"nested":[1,{"a":[]}]},` +
+		`"Cisco-IOS-XE-wireless-test-oper:entry":[{"name":"a"},{"name":"b"},{"name":"c"}]}`
+	client := newStreamTestClient(t, http.StatusOK, body)
+
+	names, err := collectStream(client, streamTestPath)
+	testutil.AssertNoError(t, err, "Stream")
+	testutil.AssertStringEquals(t, strings.Join(names, ","), "a,b,c", "streamed entries")
+}
+
+// TestCoreStreamUnit_Stream_EmptyBody tests that an empty response yields nothing.
+func TestCoreStreamUnit_Stream_EmptyBody(t *testing.T) {
+	client := newStreamTestClient(t, http.StatusNoContent, "")
+
+	names, err := collectStream(client, streamTestPath)
+	testutil.AssertNoError(t, err, "Stream")
+	testutil.AssertIntEquals(t, len(names), 0, "streamed entries")
+}
+
+// TestCoreStreamUnit_Stream_Errors tests HTTP and decoding errors.
+func TestCoreStreamUnit_Stream_Errors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"HTTP error", http.StatusNotFound, `{"errors":{}}`},
+		{"not an object", http.StatusOK, `[]`},
+		{"member not an array", http.StatusOK, `{"Cisco-IOS-XE-wireless-test-oper:entry":{"name":"a"}}`},
+		{"invalid entry", http.StatusOK, `{"Cisco-IOS-XE-wireless-test-oper:entry":[{"name":1}]}`},
+		{"truncated body", http.StatusOK, `{"Cisco-IOS-XE-wireless-test-oper:entry":[{"name":"a"}`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newStreamTestClient(t, tt.status, tt.body)
+			_, err := collectStream(client, streamTestPath)
+			testutil.AssertError(t, err, "Stream")
+		})
+	}
+
+	_, err := collectStream(newStreamTestClient(t, http.StatusNotFound, ""), streamTestPath)
+	var apiErr *APIError
+	testutil.AssertTrue(t, errors.As(err, &apiErr), "HTTP errors should be APIError")
+}
+
+// TestCoreStreamUnit_Stream_BreakReleasesSlot tests that stopping early closes the body and
+// frees the concurrency slot for the next request.
+func TestCoreStreamUnit_Stream_BreakReleasesSlot(t *testing.T) {
+	entries := make([]streamTestEntry, 1000)
+	for i := range entries {
+		entries[i].Name = fmt.Sprintf("entry-%d", i)
+	}
+	list, err := json.Marshal(entries)
+	testutil.AssertNoError(t, err, "marshal entries")
+	body := `{"Cisco-IOS-XE-wireless-test-oper:entry":` + string(list) + `}`
+	client := newStreamTestClient(t, http.StatusOK, body, WithMaxConcurrentRequests(1))
+
+	for range 2 {
+		count := 0
+		for _, err := range Stream[streamTestEntry](context.Background(), client, streamTestPath) {
+			testutil.AssertNoError(t, err, "Stream")
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		testutil.AssertIntEquals(t, count, 2, "entries before break")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = client.Do(ctx, http.MethodGet, streamTestPath)
+	testutil.AssertNoError(t, err, "slot should be released after break")
+}
+
+// TestCoreStreamUnit_Stream_NilClient tests that a nil client yields an error.
+func TestCoreStreamUnit_Stream_NilClient(t *testing.T) {
+	_, err := collectStream(nil, streamTestPath)
+	testutil.AssertError(t, err, "Stream with nil client")
+}
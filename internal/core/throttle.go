@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits outgoing requests to rps requests per second using a token bucket
+// that allows bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) error {
+		if rps <= 0 {
+			return fmt.Errorf("client configuration failed: %w",
+				fmt.Errorf("rate limit validation failed: rate must be positive, got %v", rps))
+		}
+		if burst < 1 {
+			return fmt.Errorf("client configuration failed: %w",
+				fmt.Errorf("rate limit validation failed: burst must be at least 1, got %d", burst))
+		}
+		c.rateLimiter = newRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// WithMaxConcurrentRequests caps the number of in-flight requests issued by the client.
+func WithMaxConcurrentRequests(n int) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return fmt.Errorf("client configuration failed: %w",
+				fmt.Errorf("concurrency validation failed: limit must be at least 1, got %d", n))
+		}
+		c.inflight = make(chan struct{}, n)
+		return nil
+	}
+}
+
+// acquireSlot waits for the rate limiter and a concurrency slot, honoring ctx.
+// The returned release function must be called once the request completes.
+func (c *Client) acquireSlot(ctx context.Context) (func(), error) {
+	if err := c.rateLimiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	if c.inflight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.inflight <- struct{}{}:
+		return func() { <-c.inflight }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("concurrency limit wait aborted: %w", ctx.Err())
+	}
+}
+
+// rateLimiter is a token bucket limiter shared by all requests of a client.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64   // Tokens added per second
+	burst  float64   // Bucket capacity
+	tokens float64   // Currently available tokens, negative when reserved ahead
+	last   time.Time // Last refill time
+}
+
+// newRateLimiter creates a full token bucket.
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait reserves a token and blocks until it becomes available or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		l.cancel()
+		return fmt.Errorf("rate limit wait of %v would exceed context deadline: %w",
+			delay, context.DeadlineExceeded)
+	}
+	if err := sleepWithContext(ctx, delay); err != nil {
+		l.cancel()
+		return fmt.Errorf("rate limit wait aborted: %w", err)
+	}
+	return nil
+}
+
+// reserve takes one token and returns how long the caller must wait before using it.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.burst, l.tokens+1)
+}
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// TestCoreThrottleUnit_Options_Validation tests rate limit and concurrency option validation.
+func TestCoreThrottleUnit_Options_Validation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		opt       Option
+		expectErr bool
+	}{
+		{"ValidRateLimit", WithRateLimit(10, 5), false},
+		{"ZeroRate", WithRateLimit(0, 5), true},
+		{"ZeroBurst", WithRateLimit(10, 0), true},
+		{"ValidConcurrency", WithMaxConcurrentRequests(4), false},
+		{"ZeroConcurrency", WithMaxConcurrentRequests(0), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New("test.example.com", "token", tc.opt)
+			if tc.expectErr {
+				testutil.AssertClientCreationError(t, err, tc.name)
+			} else {
+				testutil.AssertNoError(t, err, tc.name)
+			}
+		})
+	}
+}
+
+// TestCoreThrottleUnit_RateLimiter_Reserve tests token bucket accounting.
+func TestCoreThrottleUnit_RateLimiter_Reserve(t *testing.T) {
+	limiter := newRateLimiter(10, 2)
+	now := limiter.last
+
+	testutil.AssertDurationEquals(t, limiter.reserve(now), 0, "first burst token")
+	testutil.AssertDurationEquals(t, limiter.reserve(now), 0, "second burst token")
+	testutil.AssertDurationEquals(t, limiter.reserve(now), 100*time.Millisecond, "third token waits one interval")
+	testutil.AssertDurationEquals(t, limiter.reserve(now), 200*time.Millisecond, "fourth token waits two intervals")
+
+	limiter.cancel()
+	limiter.cancel()
+	testutil.AssertDurationEquals(t, limiter.reserve(now.Add(time.Second)), 0, "bucket refilled after one second")
+}
+
+// TestCoreThrottleUnit_RateLimiter_ContextDeadline tests that waits beyond the deadline fail fast.
+func TestCoreThrottleUnit_RateLimiter_ContextDeadline(t *testing.T) {
+	limiter := newRateLimiter(0.1, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	testutil.AssertNoError(t, limiter.wait(ctx), "burst token should be immediate")
+
+	start := time.Now()
+	err := limiter.wait(ctx)
+	testutil.AssertError(t, err, "wait should fail when exceeding deadline")
+	testutil.AssertTrue(t, errors.Is(err, context.DeadlineExceeded), "error should wrap DeadlineExceeded")
+	testutil.AssertTrue(t, time.Since(start) < 50*time.Millisecond, "wait should fail without sleeping")
+}
+
+// TestCoreThrottleUnit_Do_RateLimited tests that requests are spaced according to the rate.
+func TestCoreThrottleUnit_Do_RateLimited(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithRateLimit(20, 1))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	start := time.Now()
+	for range 3 {
+		_, err := client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "GET should succeed")
+	}
+	testutil.AssertTrue(t, time.Since(start) >= 90*time.Millisecond, "three requests at 20rps should take ~100ms")
+}
+
+// TestCoreThrottleUnit_Do_ConcurrencyCap tests that in-flight requests never exceed the limit.
+func TestCoreThrottleUnit_Do_ConcurrencyCap(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token",
+		WithInsecureSkipVerify(true), WithMaxConcurrentRequests(2))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			_, _ = client.Do(context.Background(), http.MethodGet, "/restconf/data/test")
+		})
+	}
+	wg.Wait()
+
+	testutil.AssertTrue(t, peak.Load() <= 2, "peak concurrency should not exceed limit")
+}
+
+// TestCoreThrottleUnit_Do_ConcurrencyContextCanceled tests that slot waits honor cancellation.
+func TestCoreThrottleUnit_Do_ConcurrencyContextCanceled(t *testing.T) {
+	client, err := New("test.example.com", "token", WithMaxConcurrentRequests(1))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+
+	client.inflight <- struct{}{} // occupy the only slot
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+	testutil.AssertError(t, err, "Do should fail when no slot becomes available")
+	testutil.AssertStringContains(t, err.Error(), "concurrency limit wait aborted", "error message")
+}
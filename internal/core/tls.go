@@ -0,0 +1,144 @@
+package core
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// TLS options rebuild the built-in transport from the accumulated settings, so they replace
+// a transport installed earlier with WithTransport or WithHTTPClient.
+
+// WithRootCAs sets the pool of trusted root CAs used to verify the controller certificate.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		if pool == nil {
+			return errors.New("root CA pool cannot be nil")
+		}
+		s.RootCAs = pool
+		return nil
+	})
+}
+
+// WithCAPEM trusts the PEM encoded CA certificates instead of the system roots.
+func WithCAPEM(pemData []byte) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		pool, err := transport.NewCertPoolFromPEM(pemData)
+		if err != nil {
+			return fmt.Errorf("CA bundle validation failed: %w", err)
+		}
+		s.RootCAs = pool
+		return nil
+	})
+}
+
+// WithCAFile trusts the PEM encoded CA certificates read from path instead of the system roots.
+func WithCAFile(path string) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		pemData, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := transport.NewCertPoolFromPEM(pemData)
+		if err != nil {
+			return fmt.Errorf("CA bundle validation failed: %w", err)
+		}
+		s.RootCAs = pool
+		return nil
+	})
+}
+
+// WithClientCertificate presents cert to controllers that require mutual TLS.
+func WithClientCertificate(cert tls.Certificate) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		if len(cert.Certificate) == 0 {
+			return errors.New("client certificate validation failed: certificate chain is empty")
+		}
+		s.Certificates = []tls.Certificate{cert}
+		return nil
+	})
+}
+
+// WithClientCertificatePEM presents the PEM encoded certificate and key for mutual TLS.
+func WithClientCertificatePEM(certPEM, keyPEM []byte) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("client certificate validation failed: %w", err)
+		}
+		s.Certificates = []tls.Certificate{cert}
+		return nil
+	})
+}
+
+// WithClientCertificateFiles presents the certificate and key read from PEM files for mutual TLS.
+func WithClientCertificateFiles(certFile, keyFile string) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("client certificate validation failed: %w", err)
+		}
+		s.Certificates = []tls.Certificate{cert}
+		return nil
+	})
+}
+
+// WithTLSMinVersion sets the minimum accepted TLS version (tls.VersionTLS12 or tls.VersionTLS13).
+func WithTLSMinVersion(version uint16) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		if version != tls.VersionTLS12 && version != tls.VersionTLS13 {
+			return fmt.Errorf("TLS version validation failed: unsupported minimum version %s",
+				tls.VersionName(version))
+		}
+		s.MinVersion = version
+		return nil
+	})
+}
+
+// WithTLSServerName overrides the server name used for certificate verification and SNI,
+// e.g. when connecting to a controller by IP address.
+func WithTLSServerName(name string) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		if name == "" {
+			return errors.New("TLS server name cannot be empty")
+		}
+		s.ServerName = name
+		return nil
+	})
+}
+
+// WithSPKIPins accepts the connection only if a certificate of the verified chain has one of the given
+// SHA-256 SubjectPublicKeyInfo pins, encoded as base64 with an optional "sha256/" prefix. When chain
+// verification is skipped, only the controller leaf certificate is matched.
+func WithSPKIPins(pins ...string) Option {
+	return withTLSSettings(func(s *transport.TLSSettings) error {
+		if len(pins) == 0 {
+			return errors.New("SPKI pin validation failed: at least one pin is required")
+		}
+		digests := make([][]byte, 0, len(pins))
+		for _, pin := range pins {
+			digest, err := transport.ParseSPKIPin(pin)
+			if err != nil {
+				return fmt.Errorf("SPKI pin validation failed: %w", err)
+			}
+			digests = append(digests, digest)
+		}
+		s.SPKIPins = digests
+		return nil
+	})
+}
+
+// withTLSSettings applies update to the client TLS settings and rebuilds the transport.
+func withTLSSettings(update func(s *transport.TLSSettings) error) Option {
+	return func(c *Client) error {
+		if err := update(&c.tlsSettings); err != nil {
+			return fmt.Errorf("client configuration failed: %w", err)
+		}
+		c.httpClient.Transport = transport.NewTransportWithTLS(c.tlsSettings)
+		return nil
+	}
+}
@@ -0,0 +1,248 @@
+package core
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// newTestClientCertificate generates a self-signed client certificate and returns it with its PEM encodings.
+func newTestClientCertificate(t *testing.T) (*x509.Certificate, []byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testutil.AssertNoError(t, err, "generate key")
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "wnc-test-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	testutil.AssertNoError(t, err, "create certificate")
+	cert, err := x509.ParseCertificate(der)
+	testutil.AssertNoError(t, err, "parse certificate")
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	testutil.AssertNoError(t, err, "marshal key")
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return cert, certPEM, keyPEM
+}
+
+// serverCAPEM returns the PEM encoding of the httptest server certificate.
+func serverCAPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// okHandler responds 200 with an empty JSON object.
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{}`))
+})
+
+// TestCoreTLSUnit_RootCAs tests verification against a custom CA bundle.
+func TestCoreTLSUnit_RootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(okHandler)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+	ctx := context.Background()
+
+	t.Run("SystemRootsRejectServer", func(t *testing.T) {
+		client, err := New(host, "token")
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertError(t, err, "untrusted certificate should be rejected")
+	})
+
+	t.Run("CAPEM", func(t *testing.T) {
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "trusted CA should be accepted")
+	})
+
+	t.Run("CAFile", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		testutil.AssertNoError(t, os.WriteFile(path, serverCAPEM(server), 0o600), "write CA file")
+		client, err := New(host, "token", WithCAFile(path))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "trusted CA file should be accepted")
+	})
+
+	t.Run("RootCAsPool", func(t *testing.T) {
+		pool := x509.NewCertPool()
+		pool.AddCert(server.Certificate())
+		client, err := New(host, "token", WithRootCAs(pool))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "trusted pool should be accepted")
+	})
+
+	t.Run("ServerNameMismatch", func(t *testing.T) {
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)), WithTLSServerName("wnc.invalid"))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertError(t, err, "mismatching server name should be rejected")
+	})
+
+	t.Run("ServerNameOverride", func(t *testing.T) {
+		// httptest certificates are issued for example.com
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)), WithTLSServerName("example.com"))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "matching server name override should be accepted")
+	})
+}
+
+// TestCoreTLSUnit_MutualTLS tests client certificate authentication.
+func TestCoreTLSUnit_MutualTLS(t *testing.T) {
+	clientCert, certPEM, keyPEM := newTestClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(okHandler)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	ctx := context.Background()
+
+	t.Run("WithoutClientCertificate", func(t *testing.T) {
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertError(t, err, "server should require a client certificate")
+	})
+
+	t.Run("WithClientCertificatePEM", func(t *testing.T) {
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)), WithClientCertificatePEM(certPEM, keyPEM))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "client certificate should be accepted")
+	})
+
+	t.Run("WithClientCertificateFiles", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+		testutil.AssertNoError(t, os.WriteFile(certFile, certPEM, 0o600), "write cert")
+		testutil.AssertNoError(t, os.WriteFile(keyFile, keyPEM, 0o600), "write key")
+
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)), WithClientCertificateFiles(certFile, keyFile))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "client certificate files should be accepted")
+	})
+}
+
+// TestCoreTLSUnit_SPKIPinning tests public key pinning.
+func TestCoreTLSUnit_SPKIPinning(t *testing.T) {
+	server := httptest.NewTLSServer(okHandler)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+	ctx := context.Background()
+
+	_, otherPEM, _ := newTestClientCertificate(t)
+	block, _ := pem.Decode(otherPEM)
+	otherCert, _ := x509.ParseCertificate(block.Bytes)
+
+	t.Run("MatchingPin", func(t *testing.T) {
+		client, err := New(host, "token", WithInsecureSkipVerify(true),
+			WithSPKIPins(transport.SPKIPin(server.Certificate())))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "pinned key should be accepted")
+	})
+
+	t.Run("MismatchingPin", func(t *testing.T) {
+		client, err := New(host, "token", WithInsecureSkipVerify(true), WithSPKIPins(transport.SPKIPin(otherCert)))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertErrorContains(t, err, "SPKI pins", "unpinned key should be rejected")
+	})
+
+	t.Run("VerifiedChainPin", func(t *testing.T) {
+		client, err := New(host, "token", WithCAPEM(serverCAPEM(server)),
+			WithSPKIPins(transport.SPKIPin(server.Certificate())))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertNoError(t, err, "pinned key in the verified chain should be accepted")
+	})
+
+	t.Run("ForgedLeafWithPinnedCertificate", func(t *testing.T) {
+		// An attacker presents its own leaf followed by the pinned controller certificate
+		_, forgedCertPEM, forgedKeyPEM := newTestClientCertificate(t)
+		forged, err := tls.X509KeyPair(forgedCertPEM, forgedKeyPEM)
+		testutil.AssertNoError(t, err, "load forged key pair")
+		forged.Certificate = append(forged.Certificate, server.Certificate().Raw)
+
+		attacker := httptest.NewUnstartedServer(okHandler)
+		attacker.TLS = &tls.Config{Certificates: []tls.Certificate{forged}}
+		attacker.StartTLS()
+		defer attacker.Close()
+
+		client, err := New(strings.TrimPrefix(attacker.URL, "https://"), "token", WithInsecureSkipVerify(true),
+			WithSPKIPins(transport.SPKIPin(server.Certificate())))
+		testutil.AssertNoError(t, err, "Client creation should succeed")
+		_, err = client.Do(ctx, http.MethodGet, "/restconf/data/test")
+		testutil.AssertErrorContains(t, err, "SPKI pins", "pinned certificate behind a forged leaf should be rejected")
+	})
+}
+
+// TestCoreTLSUnit_Options_Validation tests TLS option validation.
+func TestCoreTLSUnit_Options_Validation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		opt       Option
+		expectErr bool
+	}{
+		{"TLS12", WithTLSMinVersion(tls.VersionTLS12), false},
+		{"TLS13", WithTLSMinVersion(tls.VersionTLS13), false},
+		{"TLS10", WithTLSMinVersion(tls.VersionTLS10), true},
+		{"InvalidCAPEM", WithCAPEM([]byte("not a certificate")), true},
+		{"MissingCAFile", WithCAFile(filepath.Join(t.TempDir(), "missing.pem")), true},
+		{"NilRootCAs", WithRootCAs(nil), true},
+		{"InvalidClientPEM", WithClientCertificatePEM([]byte("x"), []byte("y")), true},
+		{"EmptyClientCertificate", WithClientCertificate(tls.Certificate{}), true},
+		{"EmptyServerName", WithTLSServerName(""), true},
+		{"NoPins", WithSPKIPins(), true},
+		{"InvalidPin", WithSPKIPins("sha256/not-base64!"), true},
+		{"ShortPin", WithSPKIPins("c2hvcnQ="), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New("controller.example.com", "token", tc.opt)
+			if tc.expectErr {
+				testutil.AssertClientCreationError(t, err, tc.name)
+			} else {
+				testutil.AssertNoError(t, err, tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ierrors "github.com/umatare5/cisco-ios-xe-wireless-go/internal/errors"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+)
+
+// PatchOperation is the operation of a YANG Patch edit (RFC 8072 section 2.5).
+type PatchOperation string
+
+// YANG Patch edit operations.
+const (
+	PatchCreate  PatchOperation = "create"  // Create the target, failing if it already exists
+	PatchMerge   PatchOperation = "merge"   // Merge the value into the target
+	PatchReplace PatchOperation = "replace" // Replace the target with the value
+	PatchDelete  PatchOperation = "delete"  // Delete the target, failing if it does not exist
+	PatchRemove  PatchOperation = "remove"  // Delete the target if it exists
+)
+
+// YANGPatchEdit is a single edit of a YANG Patch.
+type YANGPatchEdit struct {
+	EditID    string         `json:"edit-id"`         // Identifier reported back in the edit status
+	Operation PatchOperation `json:"operation"`       // Edit operation
+	Target    string         `json:"target"`          // Data resource path relative to the datastore
+	Value     any            `json:"value,omitempty"` // Request document for create, merge and replace
+}
+
+// YANGPatch batches create, merge, replace and delete edits into one atomic request.
+// The controller applies either all edits or none of them. Build it with NewYANGPatch
+// and apply it with ApplyYANGPatch:
+//
+//	patch := core.NewYANGPatch("onboard-site-a").
+//		Create(routes.SiteTagConfigsPath, siteTagPayload).
+//		Create(routes.WLANPolicyListEntriesPath, policyTagPayload)
+//	result, err := core.ApplyYANGPatch(ctx, client, patch)
+type YANGPatch struct {
+	PatchID string          // Identifier reported back in the patch status
+	Comment string          // Optional description of the change
+	Edits   []YANGPatchEdit // Edits in the order they are applied
+}
+
+// NewYANGPatch returns an empty YANG Patch identified by patchID.
+func NewYANGPatch(patchID string) *YANGPatch {
+	return &YANGPatch{PatchID: patchID}
+}
+
+// WithComment sets the description of the patch.
+func (p *YANGPatch) WithComment(comment string) *YANGPatch {
+	p.Comment = comment
+	return p
+}
+
+// Create adds an edit creating the data at target. Target is a data resource path such as
+// routes.SiteTagConfigsPath and value is the request document as passed to core.Post.
+func (p *YANGPatch) Create(target string, value any) *YANGPatch {
+	return p.add(PatchCreate, target, value)
+}
+
+// Merge adds an edit merging value into the data at target, as core.Patch does.
+func (p *YANGPatch) Merge(target string, value any) *YANGPatch {
+	return p.add(PatchMerge, target, value)
+}
+
+// Replace adds an edit replacing the data at target with value, as core.Put does.
+func (p *YANGPatch) Replace(target string, value any) *YANGPatch {
+	return p.add(PatchReplace, target, value)
+}
+
+// Delete adds an edit deleting the data at target. The patch fails when the data does not exist.
+func (p *YANGPatch) Delete(target string) *YANGPatch {
+	return p.add(PatchDelete, target, nil)
+}
+
+// Remove adds an edit deleting the data at target if it exists.
+func (p *YANGPatch) Remove(target string) *YANGPatch {
+	return p.add(PatchRemove, target, nil)
+}
+
+// add appends an edit numbered in insertion order, e.g. "edit-1".
+func (p *YANGPatch) add(op PatchOperation, target string, value any) *YANGPatch {
+	p.Edits = append(p.Edits, YANGPatchEdit{
+		EditID:    fmt.Sprintf("edit-%d", len(p.Edits)+1),
+		Operation: op,
+		Target:    patchTarget(target),
+		Value:     value,
+	})
+	return p
+}
+
+// Validate checks that the patch is complete before it is sent.
+func (p *YANGPatch) Validate() error {
+	if p == nil {
+		return errors.New("yang-patch validation failed: patch cannot be nil")
+	}
+	if p.PatchID == "" {
+		return errors.New("yang-patch validation failed: patch ID cannot be empty")
+	}
+	if len(p.Edits) == 0 {
+		return errors.New("yang-patch validation failed: patch has no edits")
+	}
+	seen := make(map[string]bool, len(p.Edits))
+	for _, edit := range p.Edits {
+		switch {
+		case edit.EditID == "":
+			return errors.New("yang-patch validation failed: edit ID cannot be empty")
+		case seen[edit.EditID]:
+			return fmt.Errorf("yang-patch validation failed: duplicate edit ID %q", edit.EditID)
+		case edit.Target == "" || edit.Target == "/":
+			return fmt.Errorf("yang-patch validation failed: edit %q has no target", edit.EditID)
+		}
+		switch edit.Operation {
+		case PatchCreate, PatchMerge, PatchReplace:
+			if edit.Value == nil {
+				return fmt.Errorf("yang-patch validation failed: %s edit %q requires a value", edit.Operation, edit.EditID)
+			}
+		case PatchDelete, PatchRemove:
+		default:
+			return fmt.Errorf("yang-patch validation failed: edit %q has unsupported operation %q",
+				edit.EditID, edit.Operation)
+		}
+		seen[edit.EditID] = true
+	}
+	return nil
+}
+
+// YANGPatchResult is the outcome of a YANG Patch, mapped to the edits of the request.
+type YANGPatchResult struct {
+	PatchID string           // Identifier of the applied patch
+	OK      bool             // True when the controller applied every edit
+	Edits   []YANGEditStatus // Status of each edit in request order
+	Errors  []RESTCONFError  // Errors not attributed to a single edit
+}
+
+// YANGEditStatus is the status of a single edit of a YANG Patch.
+//
+// Patches are atomic, so when the patch failed no edit was applied: OK then tells whether
+// the controller accepted the edit, and edits it did not reach are reported without errors.
+type YANGEditStatus struct {
+	EditID    string          // Edit identifier
+	Operation PatchOperation  // Edit operation
+	Target    string          // Edit target
+	OK        bool            // True when the edit succeeded
+	Errors    []RESTCONFError // Errors reported for the edit
+}
+
+// Failed returns the edits the controller reported errors for.
+func (r *YANGPatchResult) Failed() []YANGEditStatus {
+	if r == nil {
+		return nil
+	}
+	var failed []YANGEditStatus
+	for _, edit := range r.Edits {
+		if len(edit.Errors) > 0 {
+			failed = append(failed, edit)
+		}
+	}
+	return failed
+}
+
+// ApplyYANGPatch sends patch to the datastore as a single "application/yang-patch+json" request.
+//
+// When the controller rejects the patch, both the per-edit result and the *APIError are returned,
+// so that callers can report which edit failed and still inspect the HTTP status of the error.
+func ApplyYANGPatch(ctx context.Context, c *Client, patch *YANGPatch) (*YANGPatchResult, error) {
+	if c == nil {
+		return nil, errors.New(ierrors.ErrClientNil)
+	}
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set(transport.HTTPHeaderKeyContentType, transport.HTTPHeaderValueYANGPatch)
+	header.Set(transport.HTTPHeaderKeyAccept, transport.HTTPHeaderValueYANGData)
+	resp, err := c.send(ctx, &Request{
+		Method:  http.MethodPatch,
+		Path:    routes.RESTCONFDataPath,
+		Payload: yangPatchDocument{Patch: yangPatchBody{PatchID: patch.PatchID, Comment: patch.Comment, Edit: patch.Edits}},
+		Header:  header,
+	})
+
+	var body []byte
+	if resp != nil {
+		body = resp.Body
+	}
+	result, parsed := parseYANGPatchStatus(patch, body)
+	if err != nil {
+		if !parsed {
+			return nil, err
+		}
+		result.OK = false
+		return result, err
+	}
+	if !parsed {
+		// Controllers may answer a successful patch with 204 No Content
+		return newYANGPatchResult(patch, true), nil
+	}
+	if !result.OK {
+		return result, fmt.Errorf("yang-patch %q failed: %d edit(s) reported errors", patch.PatchID, len(result.Failed()))
+	}
+	return result, nil
+}
+
+// yangPatchDocument is the JSON encoding of a YANG Patch request.
+type yangPatchDocument struct {
+	Patch yangPatchBody `json:"ietf-yang-patch:yang-patch"`
+}
+
+// yangPatchBody is the yang-patch container.
+type yangPatchBody struct {
+	PatchID string          `json:"patch-id"`
+	Comment string          `json:"comment,omitempty"`
+	Edit    []YANGPatchEdit `json:"edit"`
+}
+
+// yangPatchStatusDocument is the JSON encoding of a YANG Patch status response.
+type yangPatchStatusDocument struct {
+	Status *yangPatchStatusBody `json:"ietf-yang-patch:yang-patch-status"`
+}
+
+// yangPatchStatusBody is the yang-patch-status container.
+type yangPatchStatusBody struct {
+	PatchID    string             `json:"patch-id"`
+	OK         json.RawMessage    `json:"ok"`
+	Errors     *restconfErrorList `json:"errors"`
+	EditStatus *struct {
+		Edit []struct {
+			EditID string             `json:"edit-id"`
+			OK     json.RawMessage    `json:"ok"`
+			Errors *restconfErrorList `json:"errors"`
+		} `json:"edit"`
+	} `json:"edit-status"`
+}
+
+// parseYANGPatchStatus maps a yang-patch-status body to the edits of patch.
+// It reports false when the body is not a YANG Patch status document.
+func parseYANGPatchStatus(patch *YANGPatch, body []byte) (*YANGPatchResult, bool) {
+	if len(body) == 0 {
+		return nil, false
+	}
+	var doc yangPatchStatusDocument
+	if err := json.Unmarshal(body, &doc); err != nil || doc.Status == nil {
+		return nil, false
+	}
+
+	status := doc.Status
+	result := newYANGPatchResult(patch, status.OK != nil)
+	if status.PatchID != "" {
+		result.PatchID = status.PatchID
+	}
+	if status.Errors != nil {
+		result.Errors = status.Errors.Error
+		result.OK = false
+	}
+	if status.EditStatus == nil {
+		return result, true
+	}
+
+	index := make(map[string]int, len(result.Edits))
+	for i, edit := range result.Edits {
+		index[edit.EditID] = i
+	}
+	for _, edit := range status.EditStatus.Edit {
+		i, ok := index[edit.EditID]
+		if !ok {
+			continue
+		}
+		result.Edits[i].OK = edit.OK != nil
+		if edit.Errors != nil && len(edit.Errors.Error) > 0 {
+			result.Edits[i].OK = false
+			result.Edits[i].Errors = edit.Errors.Error
+			result.OK = false
+		}
+	}
+	return result, true
+}
+
+// newYANGPatchResult returns a result listing the edits of patch with the given status.
+func newYANGPatchResult(patch *YANGPatch, ok bool) *YANGPatchResult {
+	result := &YANGPatchResult{PatchID: patch.PatchID, OK: ok, Edits: make([]YANGEditStatus, len(patch.Edits))}
+	for i, edit := range patch.Edits {
+		result.Edits[i] = YANGEditStatus{EditID: edit.EditID, Operation: edit.Operation, Target: edit.Target, OK: ok}
+	}
+	return result
+}
+
+// patchTarget converts a data resource path into an edit target relative to the datastore.
+func patchTarget(path string) string {
+	target := strings.TrimPrefix(path, routes.RESTCONFDataPath)
+	if !strings.HasPrefix(target, "/") {
+		target = "/" + target
+	}
+	return target
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// yangPatchTestRequest records the YANG Patch request received by the test server.
+type yangPatchTestRequest struct {
+	method      string
+	path        string
+	contentType string
+	document    yangPatchDocument
+}
+
+// newYANGPatchTestClient returns a client for a server that records the request
+// and answers with status and body.
+func newYANGPatchTestClient(t *testing.T, status int, body string) (*Client, *yangPatchTestRequest) {
+	t.Helper()
+	recorded := &yangPatchTestRequest{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorded.method = r.Method
+		recorded.path = r.URL.Path
+		recorded.contentType = r.Header.Get("Content-Type")
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &recorded.document)
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+	testutil.AssertNoError(t, err, "Client creation should succeed")
+	return client, recorded
+}
+
+// newOnboardingPatch returns a patch creating a site tag and an RF tag and deleting a policy tag.
+func newOnboardingPatch() *YANGPatch {
+	return NewYANGPatch("onboard-site-a").WithComment("onboard site A").
+		Create(routes.SiteTagConfigsPath, map[string]any{
+			"Cisco-IOS-XE-wireless-site-cfg:site-tag-config": map[string]string{"site-tag-name": "site-a"},
+		}).
+		Merge("Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags", map[string]any{
+			"Cisco-IOS-XE-wireless-rf-cfg:rf-tag": map[string]string{"tag-name": "rf-a"},
+		}).
+		Delete(routes.WLANPolicyListEntriesPath + "/policy-list-entry=old")
+}
+
+// TestCoreYANGPatchUnit_Apply_Success tests the request document and a successful status.
+func TestCoreYANGPatchUnit_Apply_Success(t *testing.T) {
+	client, recorded := newYANGPatchTestClient(t, http.StatusOK,
+		`{"ietf-yang-patch:yang-patch-status":{"patch-id":"onboard-site-a","ok":[null]}}`)
+
+	result, err := ApplyYANGPatch(context.Background(), client, newOnboardingPatch())
+	testutil.AssertNoError(t, err, "ApplyYANGPatch")
+	testutil.AssertTrue(t, result.OK, "patch status")
+	testutil.AssertIntEquals(t, len(result.Edits), 3, "edit statuses")
+	for _, edit := range result.Edits {
+		testutil.AssertTrue(t, edit.OK, edit.EditID)
+	}
+
+	testutil.AssertStringEquals(t, recorded.method, http.MethodPatch, "method")
+	testutil.AssertStringEquals(t, recorded.path, routes.RESTCONFDataPath, "patch resource")
+	testutil.AssertStringEquals(t, recorded.contentType, "application/yang-patch+json", "Content-Type")
+
+	patch := recorded.document.Patch
+	testutil.AssertStringEquals(t, patch.PatchID, "onboard-site-a", "patch-id")
+	testutil.AssertStringEquals(t, patch.Comment, "onboard site A", "comment")
+	testutil.AssertIntEquals(t, len(patch.Edit), 3, "edits")
+	testutil.AssertStringEquals(t, patch.Edit[0].EditID, "edit-1", "edit-id")
+	testutil.AssertStringEquals(t, string(patch.Edit[0].Operation), "create", "operation")
+	testutil.AssertStringEquals(t, patch.Edit[0].Target,
+		"/Cisco-IOS-XE-wireless-site-cfg:site-cfg-data/site-tag-configs", "absolute target")
+	testutil.AssertStringEquals(t, patch.Edit[1].Target,
+		"/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags", "relative target")
+	testutil.AssertNil(t, patch.Edit[2].Value, "delete value")
+}
+
+// TestCoreYANGPatchUnit_Apply_NoContent tests a successful patch answered without a body.
+func TestCoreYANGPatchUnit_Apply_NoContent(t *testing.T) {
+	client, _ := newYANGPatchTestClient(t, http.StatusNoContent, "")
+
+	result, err := ApplyYANGPatch(context.Background(), client, newOnboardingPatch())
+	testutil.AssertNoError(t, err, "ApplyYANGPatch")
+	testutil.AssertTrue(t, result.OK, "patch status")
+	testutil.AssertIntEquals(t, len(result.Failed()), 0, "failed edits")
+}
+
+// TestCoreYANGPatchUnit_Apply_EditFailure tests mapping of a rejected patch to per-edit status.
+func TestCoreYANGPatchUnit_Apply_EditFailure(t *testing.T) {
+	client, _ := newYANGPatchTestClient(t, http.StatusConflict, `{"ietf-yang-patch:yang-patch-status":{
+		"patch-id":"onboard-site-a",
+		"edit-status":{"edit":[
+			{"edit-id":"edit-1","ok":[null]},
+			{"edit-id":"edit-2","errors":{"error":[{"error-type":"application","error-tag":"data-exists",
+				"error-path":"/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags/rf-tag=rf-a",
+				"error-message":"object already exists"}]}}
+		]}}}`)
+
+	result, err := ApplyYANGPatch(context.Background(), client, newOnboardingPatch())
+	testutil.AssertError(t, err, "ApplyYANGPatch should fail")
+	var apiErr *APIError
+	testutil.AssertTrue(t, errors.As(err, &apiErr) && apiErr.StatusCode == StatusConflict,
+		"error should keep the HTTP status")
+	testutil.AssertNotNil(t, result, "result of rejected patch")
+	testutil.AssertFalse(t, result.OK, "patch status")
+
+	testutil.AssertTrue(t, result.Edits[0].OK, "edit-1 status")
+	testutil.AssertFalse(t, result.Edits[1].OK, "edit-2 status")
+	testutil.AssertFalse(t, result.Edits[2].OK, "edit-3 was not reached")
+	testutil.AssertIntEquals(t, len(result.Edits[2].Errors), 0, "edit-3 errors")
+
+	failed := result.Failed()
+	testutil.AssertIntEquals(t, len(failed), 1, "failed edits")
+	testutil.AssertStringEquals(t, failed[0].EditID, "edit-2", "failed edit")
+	testutil.AssertStringEquals(t, failed[0].Errors[0].Tag, ErrorTagDataExists, "error-tag")
+	testutil.AssertStringContains(t, failed[0].Target, "rf-tags", "failed target")
+}
+
+// TestCoreYANGPatchUnit_Apply_GlobalError tests errors not attributed to an edit and plain errors.
+func TestCoreYANGPatchUnit_Apply_GlobalError(t *testing.T) {
+	client, _ := newYANGPatchTestClient(t, http.StatusBadRequest, `{"ietf-yang-patch:yang-patch-status":{
+		"patch-id":"onboard-site-a",
+		"errors":{"error":[{"error-type":"protocol","error-tag":"lock-denied"}]}}}`)
+
+	result, err := ApplyYANGPatch(context.Background(), client, newOnboardingPatch())
+	testutil.AssertError(t, err, "ApplyYANGPatch should fail")
+	testutil.AssertFalse(t, result.OK, "patch status")
+	testutil.AssertIntEquals(t, len(result.Errors), 1, "global errors")
+	testutil.AssertStringEquals(t, result.Errors[0].Tag, ErrorTagLockDenied, "error-tag")
+
+	plain, _ := newYANGPatchTestClient(t, http.StatusInternalServerError, "internal error")
+	result, err = ApplyYANGPatch(context.Background(), plain, newOnboardingPatch())
+	testutil.AssertError(t, err, "ApplyYANGPatch should fail")
+	testutil.AssertPointerNil(t, result, "result without status document")
+}
+
+// TestCoreYANGPatchUnit_Validate_Failure tests that invalid patches are rejected before sending.
+func TestCoreYANGPatchUnit_Validate_Failure(t *testing.T) {
+	testCases := []struct {
+		name  string
+		patch *YANGPatch
+	}{
+		{"NilPatch", nil},
+		{"EmptyPatchID", NewYANGPatch("").Delete(routes.SiteTagConfigsPath)},
+		{"NoEdits", NewYANGPatch("p")},
+		{"MissingValue", NewYANGPatch("p").Merge(routes.SiteTagConfigsPath, nil)},
+		{"MissingTarget", NewYANGPatch("p").Remove(routes.RESTCONFDataPath)},
+		{"UnsupportedOperation", &YANGPatch{PatchID: "p", Edits: []YANGPatchEdit{
+			{EditID: "e", Operation: "insert", Target: "/a:b"},
+		}}},
+		{"DuplicateEditID", &YANGPatch{PatchID: "p", Edits: []YANGPatchEdit{
+			{EditID: "e", Operation: PatchDelete, Target: "/a:b"},
+			{EditID: "e", Operation: PatchDelete, Target: "/a:c"},
+		}}},
+	}
+
+	client, recorded := newYANGPatchTestClient(t, http.StatusOK, "")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testutil.AssertError(t, tc.patch.Validate(), "Validate")
+			_, err := ApplyYANGPatch(context.Background(), client, tc.patch)
+			testutil.AssertErrorContains(t, err, "yang-patch validation failed", "ApplyYANGPatch")
+		})
+	}
+	testutil.AssertStringEquals(t, recorded.method, "", "no request should be sent")
+
+	_, err := ApplyYANGPatch(context.Background(), nil, newOnboardingPatch())
+	testutil.AssertError(t, err, "nil client")
+}
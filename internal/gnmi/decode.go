@@ -0,0 +1,144 @@
+package gnmi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Entries returns the entries of the subscribed list or container node carried by an update
+// at path, as RFC 7951 JSON objects.
+//
+// The update may target the node itself, an ancestor whose value contains the node, or a leaf
+// or container below one entry; in the last case the entry holds only the updated members.
+// List keys present in the path but missing from the value are added as strings.
+func Entries(path *gpb.Path, val *gpb.TypedValue, node string) ([]json.RawMessage, error) {
+	raw, err := valueJSON(val)
+	if err != nil {
+		return nil, err
+	}
+
+	elems := path.GetElem()
+	at := -1
+	for i := len(elems) - 1; i >= 0; i-- {
+		if localName(elems[i].GetName()) == node {
+			at = i
+			break
+		}
+	}
+	if at < 0 {
+		// The update targets an ancestor, so the node is a member of the value
+		member, ok := findMember(raw, node)
+		if !ok {
+			return nil, nil
+		}
+		return splitEntries(member, nil)
+	}
+
+	// Rebuild the entry around values of leaves and containers below it
+	for i := len(elems) - 1; i > at; i-- {
+		if elems[i].GetKey() != nil {
+			if raw, err = mergeKeys(raw, elems[i].GetKey()); err != nil {
+				return nil, err
+			}
+			raw = append(append(json.RawMessage{'['}, raw...), ']')
+		}
+		if raw, err = json.Marshal(map[string]json.RawMessage{localName(elems[i].GetName()): raw}); err != nil {
+			return nil, err
+		}
+	}
+	return splitEntries(raw, elems[at].GetKey())
+}
+
+// valueJSON returns the JSON encoding of a typed value.
+func valueJSON(val *gpb.TypedValue) (json.RawMessage, error) {
+	var v any
+	switch value := val.GetValue().(type) {
+	case *gpb.TypedValue_JsonIetfVal:
+		return value.JsonIetfVal, nil
+	case *gpb.TypedValue_JsonVal:
+		return value.JsonVal, nil
+	case *gpb.TypedValue_StringVal:
+		v = value.StringVal
+	case *gpb.TypedValue_IntVal:
+		v = value.IntVal
+	case *gpb.TypedValue_UintVal:
+		v = value.UintVal
+	case *gpb.TypedValue_BoolVal:
+		v = value.BoolVal
+	case *gpb.TypedValue_DoubleVal:
+		v = value.DoubleVal
+	case *gpb.TypedValue_AsciiVal:
+		v = value.AsciiVal
+	case nil:
+		return nil, errors.New("update has no value")
+	default:
+		return nil, fmt.Errorf("unsupported value type %T, subscribe with JSON or JSON_IETF encoding", value)
+	}
+	return json.Marshal(v)
+}
+
+// findMember searches the objects of raw breadth-first for the member named node.
+func findMember(raw json.RawMessage, node string) (json.RawMessage, bool) {
+	queue := []json.RawMessage{raw}
+	for len(queue) > 0 {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(queue[0], &object); err != nil {
+			queue = queue[1:]
+			continue
+		}
+		queue = queue[1:]
+		for name, value := range object {
+			if localName(name) == node {
+				return value, true
+			}
+			queue = append(queue, value)
+		}
+	}
+	return nil, false
+}
+
+// splitEntries returns the entries of an array, or raw itself, with keys merged into each.
+func splitEntries(raw json.RawMessage, keys map[string]string) ([]json.RawMessage, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		entries = []json.RawMessage{raw}
+	}
+	for i, entry := range entries {
+		merged, err := mergeKeys(entry, keys)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = merged
+	}
+	return entries, nil
+}
+
+// mergeKeys adds the keys missing from the JSON object raw. Other values are returned unchanged.
+func mergeKeys(raw json.RawMessage, keys map[string]string) (json.RawMessage, error) {
+	if len(keys) == 0 {
+		return raw, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+		return raw, nil
+	}
+	added := false
+	for key, value := range keys {
+		if _, ok := object[key]; ok {
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		object[key] = encoded
+		added = true
+	}
+	if !added {
+		return raw, nil
+	}
+	return json.Marshal(object)
+}
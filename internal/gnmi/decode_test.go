@@ -0,0 +1,106 @@
+package gnmi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// commonOperData mirrors the shape of a client list entry.
+type commonOperData struct {
+	ClientMAC string `json:"client-mac"`
+	APName    string `json:"ap-name,omitempty"`
+	MsApSlot  int    `json:"ms-ap-slot-id,omitempty"`
+}
+
+// clientPath returns the client common-oper-data path with optional key and trailing elements.
+func clientPath(mac string, trailing ...string) *gpb.Path {
+	path, _ := PathFromRoute(routes.ClientCommonOperDataPath)
+	if mac != "" {
+		path.Elem[len(path.Elem)-1].Key = map[string]string{"client-mac": mac}
+	}
+	for _, name := range trailing {
+		path.Elem = append(path.Elem, &gpb.PathElem{Name: name})
+	}
+	return path
+}
+
+// jsonIETF returns a JSON_IETF typed value.
+func jsonIETF(document string) *gpb.TypedValue {
+	return &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(document)}}
+}
+
+// decodeEntries decodes the entries of an update into commonOperData values.
+func decodeEntries(t *testing.T, path *gpb.Path, val *gpb.TypedValue) []commonOperData {
+	t.Helper()
+	raw, err := Entries(path, val, "common-oper-data")
+	testutil.AssertNoError(t, err, "Entries")
+	out := make([]commonOperData, len(raw))
+	for i, entry := range raw {
+		testutil.AssertNoError(t, json.Unmarshal(entry, &out[i]), "decode "+string(entry))
+	}
+	return out
+}
+
+func TestGNMIPathUnit_PathFromRoute(t *testing.T) {
+	path, err := PathFromRoute(routes.ClientCommonOperDataPath + "?depth=2")
+	testutil.AssertNoError(t, err, "PathFromRoute")
+	testutil.AssertStringEquals(t, path.GetOrigin(), OriginRFC7951, "origin")
+	testutil.AssertStringEquals(t, PathString(path),
+		"/Cisco-IOS-XE-wireless-client-oper:client-oper-data/common-oper-data", "elements")
+
+	for _, route := range []string{
+		routes.RESTCONFDataPath, routes.APCapwapDataPath + "=00:11:22:33:44:55", "/restconf/data/no-module",
+	} {
+		_, err := PathFromRoute(route)
+		testutil.AssertError(t, err, "PathFromRoute("+route+")")
+	}
+
+	keyed := clientPath(`aa]b`)
+	testutil.AssertTrue(t, strings.HasSuffix(PathString(keyed), `common-oper-data[client-mac=aa\]b]`), "escaped key")
+	testutil.AssertStringEquals(t, Keys(keyed)["client-mac"], "aa]b", "keys")
+	testutil.AssertStringEquals(t, PathString(Join(nil, keyed)), PathString(keyed), "join with nil prefix")
+}
+
+func TestGNMIDecodeUnit_Entries(t *testing.T) {
+	t.Run("EntryWithKeyInPathOnly", func(t *testing.T) {
+		got := decodeEntries(t, clientPath("aa:bb"), jsonIETF(`{"ap-name":"ap1","ms-ap-slot-id":1}`))
+		testutil.AssertIntEquals(t, len(got), 1, "entries")
+		testutil.AssertStringEquals(t, got[0].ClientMAC, "aa:bb", "key merged from path")
+		testutil.AssertIntEquals(t, got[0].MsApSlot, 1, "number leaf")
+	})
+
+	t.Run("ListValue", func(t *testing.T) {
+		got := decodeEntries(t, clientPath(""), jsonIETF(`[{"client-mac":"a"},{"client-mac":"b"}]`))
+		testutil.AssertIntEquals(t, len(got), 2, "array entries")
+	})
+
+	t.Run("AncestorValue", func(t *testing.T) {
+		path := clientPath("")
+		path.Elem = path.Elem[:1]
+		got := decodeEntries(t, path, jsonIETF(
+			`{"Cisco-IOS-XE-wireless-client-oper:common-oper-data":[{"client-mac":"a"}],"other":{}}`))
+		testutil.AssertIntEquals(t, len(got), 1, "member of ancestor")
+		testutil.AssertStringEquals(t, got[0].ClientMAC, "a", "entry")
+	})
+
+	t.Run("LeafBelowEntry", func(t *testing.T) {
+		leaf := &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "ap2"}}
+		got := decodeEntries(t, clientPath("cc", "ap-name"), leaf)
+		testutil.AssertStringEquals(t, got[0].ClientMAC, "cc", "key")
+		testutil.AssertStringEquals(t, got[0].APName, "ap2", "partial entry")
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		proto := &gpb.TypedValue{Value: &gpb.TypedValue_ProtoBytes{ProtoBytes: []byte{1}}}
+		_, err := Entries(clientPath("a"), proto, "common-oper-data")
+		testutil.AssertErrorContains(t, err, "JSON_IETF", "proto encoding")
+		_, err = Entries(clientPath("a"), nil, "common-oper-data")
+		testutil.AssertError(t, err, "missing value")
+	})
+}
@@ -0,0 +1,7 @@
+// Package gnmi maps RESTCONF routes onto gNMI paths and decodes model-driven telemetry updates.
+//
+// Contains PathFromRoute for building subscription paths from the routes constants, PathString for
+// rendering received paths, and Entries for turning JSON and JSON_IETF encoded updates into
+// RFC 7951 JSON list entries that decode into the existing typed structs. List keys carried only
+// by the path are merged into the entries.
+package gnmi
@@ -0,0 +1,90 @@
+package gnmi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+)
+
+// OriginRFC7951 is the path origin IOS-XE uses for module-qualified YANG paths.
+const OriginRFC7951 = "rfc7951"
+
+// PathFromRoute converts a RESTCONF data route such as routes.ClientCommonOperDataPath into a
+// gNMI path. List keys are not supported, since RESTCONF paths carry only key values.
+func PathFromRoute(route string) (*gpb.Path, error) {
+	route, _, _ = strings.Cut(route, "?")
+	route = strings.Trim(strings.TrimPrefix(route, routes.RESTCONFDataPath), "/")
+	if route == "" {
+		return nil, errors.New("path validation failed: route must name a data node below the datastore root")
+	}
+
+	path := &gpb.Path{Origin: OriginRFC7951}
+	for i, segment := range strings.Split(route, "/") {
+		if strings.Contains(segment, "=") {
+			return nil, fmt.Errorf("path validation failed: list keys are not supported in %q", route)
+		}
+		if segment == "" || (i == 0 && !strings.Contains(segment, ":")) {
+			return nil, fmt.Errorf("path validation failed: invalid route %q", route)
+		}
+		path.Elem = append(path.Elem, &gpb.PathElem{Name: segment})
+	}
+	return path, nil
+}
+
+// Join returns the path of an update below prefix. Either may be nil.
+func Join(prefix, path *gpb.Path) *gpb.Path {
+	joined := &gpb.Path{Origin: prefix.GetOrigin(), Target: prefix.GetTarget()}
+	if joined.Origin == "" {
+		joined.Origin = path.GetOrigin()
+	}
+	joined.Elem = slices.Concat(prefix.GetElem(), path.GetElem())
+	return joined
+}
+
+// PathString renders path as "/module:node/list[key=value]/leaf" with keys sorted by name.
+func PathString(path *gpb.Path) string {
+	var sb strings.Builder
+	for _, elem := range path.GetElem() {
+		sb.WriteString("/" + elem.GetName())
+		keys := make([]string, 0, len(elem.GetKey()))
+		for key := range elem.GetKey() {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			value := strings.NewReplacer(`\`, `\\`, `]`, `\]`).Replace(elem.GetKey()[key])
+			sb.WriteString("[" + key + "=" + value + "]")
+		}
+	}
+	if sb.Len() == 0 {
+		return "/"
+	}
+	return sb.String()
+}
+
+// Keys returns the list keys of path, collected from all its elements.
+func Keys(path *gpb.Path) map[string]string {
+	var keys map[string]string
+	for _, elem := range path.GetElem() {
+		for key, value := range elem.GetKey() {
+			if keys == nil {
+				keys = map[string]string{}
+			}
+			keys[key] = value
+		}
+	}
+	return keys
+}
+
+// localName returns a node name without its module prefix.
+func localName(name string) string {
+	if _, local, ok := strings.Cut(name, ":"); ok {
+		return local
+	}
+	return name
+}
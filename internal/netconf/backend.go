@@ -0,0 +1,469 @@
+package netconf
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+)
+
+// Call is a RESTCONF call carried over NETCONF.
+type Call struct {
+	Method  string // HTTP method
+	Path    string // RESTCONF data or operations path, optionally with a query
+	Payload []byte // RFC 7951 JSON request document, nil for none
+	RPC     bool   // True for RESTCONF operations (RPC) calls
+}
+
+// Backend carries RESTCONF calls over NETCONF sessions, one per controller, dialed on first use.
+//
+// GETs on "-cfg" modules use <get-config> on the running datastore and other GETs <get>, with a
+// subtree filter selecting the path. POST, PUT, PATCH and DELETE become an <edit-config> with the
+// create, replace, merge and delete operations. Since RESTCONF paths carry only key values, key
+// leaf names of lists are learned from the first reply holding their entries and cached. Until
+// then the filter stops at the list and its entries are matched on the client; afterwards list
+// entries are selected by content-match nodes on the controller. Replies are returned as XML
+// wrapped in a <data> element; decode them with ToJSON.
+type Backend struct {
+	port   int
+	config func(ctx context.Context) (Config, error)
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+	keys     map[string][]xml.Name // Key leaf names by list, see listID
+}
+
+// NewBackend returns a backend connecting to port. config is called for every new session,
+// so that rotated credentials are picked up on reconnection.
+func NewBackend(port int, config func(ctx context.Context) (Config, error)) *Backend {
+	if port <= 0 {
+		port = DefaultPort
+	}
+	return &Backend{port: port, config: config, sessions: map[string]*Session{}, keys: map[string][]xml.Name{}}
+}
+
+// Do carries call to the controller at host and returns the RESTCONF status code and body.
+// Errors reported by the controller are returned as *Error.
+func (b *Backend) Do(ctx context.Context, host string, call Call) (int, []byte, error) {
+	session, err := b.session(ctx, host)
+	if err != nil {
+		return 0, nil, err
+	}
+	switch {
+	case call.RPC:
+		return b.invoke(ctx, session, call)
+	case call.Method == http.MethodGet:
+		return b.get(ctx, session, call)
+	case call.Method == http.MethodPost, call.Method == http.MethodPut,
+		call.Method == http.MethodPatch, call.Method == http.MethodDelete:
+		return b.edit(ctx, session, call)
+	default:
+		return 0, nil, &Error{
+			Status:  http.StatusMethodNotAllowed,
+			Message: fmt.Sprintf("NETCONF error: method %s is not supported", call.Method),
+		}
+	}
+}
+
+// Ping checks that a NETCONF session to host can be established, reusing an open one.
+func (b *Backend) Ping(ctx context.Context, host string) error {
+	_, err := b.session(ctx, host)
+	return err
+}
+
+// Close ends every open session.
+func (b *Backend) Close() error {
+	b.mu.Lock()
+	sessions := b.sessions
+	b.sessions = map[string]*Session{}
+	b.mu.Unlock()
+
+	var errs []error
+	for _, session := range sessions {
+		errs = append(errs, session.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// session returns the open session to host, dialing a new one when needed.
+func (b *Backend) session(ctx context.Context, host string) (*Session, error) {
+	address := b.address(host)
+	b.mu.Lock()
+	session := b.sessions[address]
+	b.mu.Unlock()
+	if session != nil && !session.Closed() {
+		return session, nil
+	}
+
+	cfg, err := b.config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err = Dial(ctx, address, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if existing := b.sessions[address]; existing != nil && !existing.Closed() {
+		// Another call connected meanwhile
+		go session.Close()
+		return existing, nil
+	}
+	b.sessions[address] = session
+	return session, nil
+}
+
+// address returns the NETCONF address of a controller given as "host" or "host:port".
+func (b *Backend) address(host string) string {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(b.port))
+}
+
+// get retrieves the resource of a data path.
+func (b *Backend) get(ctx context.Context, session *Session, call Call) (int, []byte, error) {
+	segments, query, err := parsePath(call.Path, routes.RESTCONFDataPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	config := strings.HasSuffix(ModuleOf(segments[0].ns), "-cfg")
+	switch query.Get("content") {
+	case "config":
+		config = true
+	case "nonconfig":
+		config = false
+	}
+
+	nodes, err := b.fetch(ctx, session, segments, config)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(nodes) == 0 {
+		return 0, nil, notFound(call.Path)
+	}
+	return http.StatusOK, dataNode(nodes...).Marshal(), nil
+}
+
+// fetch retrieves the nodes addressed by segments.
+func (b *Backend) fetch(ctx context.Context, session *Session, segments []segment, config bool) ([]*Node, error) {
+	var op *Node
+	if config {
+		op = baseNode("get-config", baseNode("source", baseNode("running")), b.subtreeFilter(segments))
+	} else {
+		op = baseNode("get", b.subtreeFilter(segments))
+	}
+	reply, err := session.Exec(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	data := reply.Child("data")
+	if data == nil {
+		return nil, nil
+	}
+	b.learnKeys(data.Children, segments)
+	return selectNodes(data.Children, segments), nil
+}
+
+// edit changes the resource of a data path with <edit-config>.
+func (b *Backend) edit(ctx context.Context, session *Session, call Call) (int, []byte, error) {
+	segments, _, err := parsePath(call.Path, routes.RESTCONFDataPath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var payload []*Node
+	if call.Method != http.MethodDelete {
+		if len(call.Payload) == 0 {
+			return 0, nil, badRequest("request payload is required")
+		}
+		if payload, err = FromJSON(call.Payload); err != nil {
+			return 0, nil, badRequest(err.Error())
+		}
+	}
+
+	parents, status := segments[:len(segments)-1], http.StatusNoContent
+	switch call.Method {
+	case http.MethodPost:
+		parents, status = segments, http.StatusCreated
+		setOperation(payload, "create")
+	case http.MethodPut:
+		setOperation(payload, "replace")
+	case http.MethodDelete:
+		target, err := b.element(ctx, session, segments)
+		if err != nil {
+			return 0, nil, err
+		}
+		payload = []*Node{target}
+		setOperation(payload, "delete")
+	}
+
+	config := baseNode("config")
+	parent := config
+	for i := range parents {
+		element, err := b.element(ctx, session, parents[:i+1])
+		if err != nil {
+			return 0, nil, err
+		}
+		parent.Children = append(parent.Children, element)
+		parent = element
+	}
+	parent.Children = append(parent.Children, payload...)
+
+	op := baseNode("edit-config", baseNode("target", baseNode("running")), config)
+	if _, err := session.Exec(ctx, op); err != nil {
+		return 0, nil, err
+	}
+	return status, nil, nil
+}
+
+// element returns the element of the last segment, with the key leaves of a list entry.
+func (b *Backend) element(ctx context.Context, session *Session, segments []segment) (*Node, error) {
+	last := segments[len(segments)-1]
+	element := &Node{Name: xml.Name{Space: last.ns, Local: last.name}}
+	if !last.keyed {
+		return element, nil
+	}
+
+	entries, err := b.fetch(ctx, session, segments, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, notFound(last.name + "=" + strings.Join(last.keys, ","))
+	}
+	for i := range last.keys {
+		key := entries[0].Children[i]
+		element.Children = append(element.Children, &Node{Name: key.Name, Text: key.Text})
+	}
+	return element, nil
+}
+
+// invoke calls the RPC of an operations path.
+func (b *Backend) invoke(ctx context.Context, session *Session, call Call) (int, []byte, error) {
+	segments, _, err := parsePath(call.Path, routes.RESTCONFOperationsPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(segments) != 1 || segments[0].keyed {
+		return 0, nil, badRequest("invalid operation path " + call.Path)
+	}
+	rpc := &Node{Name: xml.Name{Space: segments[0].ns, Local: segments[0].name}}
+	if len(call.Payload) > 0 {
+		payload, err := FromJSON(call.Payload)
+		if err != nil {
+			return 0, nil, badRequest(err.Error())
+		}
+		for _, node := range payload {
+			if node.Name.Local == "input" {
+				rpc.Children = append(rpc.Children, node.Children...)
+			}
+		}
+	}
+
+	reply, err := session.Exec(ctx, rpc)
+	if err != nil {
+		return 0, nil, err
+	}
+	output := &Node{Name: xml.Name{Space: segments[0].ns, Local: "output"}}
+	for _, child := range reply.Children {
+		if child.Name.Local != "ok" {
+			output.Children = append(output.Children, child)
+		}
+	}
+	if len(output.Children) == 0 {
+		return http.StatusNoContent, nil, nil
+	}
+	return http.StatusOK, dataNode(output).Marshal(), nil
+}
+
+// segment is a node of a RESTCONF path.
+type segment struct {
+	ns    string   // Namespace of the node's module
+	name  string   // Node name
+	keys  []string // Unescaped key values of a list entry
+	keyed bool     // True for list entries
+}
+
+// parsePath splits a RESTCONF path below root into segments and its query parameters.
+func parsePath(path, root string) ([]segment, url.Values, error) {
+	path, rawQuery, _ := strings.Cut(path, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, nil, badRequest("invalid query: " + err.Error())
+	}
+	path = strings.Trim(strings.TrimPrefix(path, root), "/")
+	if path == "" {
+		return nil, nil, badRequest("the datastore root is not supported over NETCONF")
+	}
+
+	var (
+		segments []segment
+		ns       string
+	)
+	for _, part := range strings.Split(path, "/") {
+		name, rawKeys, keyed := strings.Cut(part, "=")
+		if module, local, qualified := strings.Cut(name, ":"); qualified {
+			ns, name = Namespace(module), local
+		}
+		if ns == "" || name == "" {
+			return nil, nil, badRequest("invalid path " + path)
+		}
+		seg := segment{ns: ns, name: name, keyed: keyed}
+		if keyed {
+			for _, rawKey := range strings.Split(rawKeys, ",") {
+				key, err := url.PathUnescape(rawKey)
+				if err != nil {
+					return nil, nil, badRequest("invalid key in path " + path)
+				}
+				seg.keys = append(seg.keys, key)
+			}
+		}
+		segments = append(segments, seg)
+	}
+	return segments, query, nil
+}
+
+// subtreeFilter returns a subtree filter selecting segments. List entries are selected by
+// content-match nodes of their key leaves; the filter stops at the first list whose key leaf
+// names are not known yet, and its entries are selected whole.
+func (b *Backend) subtreeFilter(segments []segment) *Node {
+	filter := baseNode("filter")
+	filter.Attrs = []xml.Attr{{Name: xml.Name{Local: "type"}, Value: "subtree"}}
+	parent := filter
+	for i, seg := range segments {
+		node := &Node{Name: xml.Name{Space: seg.ns, Local: seg.name}}
+		parent.Children = []*Node{node}
+		parent = node
+		if !seg.keyed {
+			continue
+		}
+		b.mu.Lock()
+		names := b.keys[listID(segments[:i+1])]
+		b.mu.Unlock()
+		if len(names) != len(seg.keys) {
+			break
+		}
+		for j, name := range names {
+			node.Children = append(node.Children, &Node{Name: name, Text: seg.keys[j]})
+		}
+	}
+	return filter
+}
+
+// learnKeys caches the key leaf names of the lists along segments, taken from the leading
+// leaves of their entries in nodes.
+func (b *Backend) learnKeys(nodes []*Node, segments []segment) {
+	for i, seg := range segments {
+		if !seg.keyed {
+			continue
+		}
+		id := listID(segments[:i+1])
+		b.mu.Lock()
+		_, known := b.keys[id]
+		b.mu.Unlock()
+		if known {
+			continue
+		}
+
+		list := slices.Clone(segments[:i+1])
+		list[i].keyed = false
+		for _, entry := range selectNodes(nodes, list) {
+			if len(entry.Children) < len(seg.keys) {
+				continue
+			}
+			names := make([]xml.Name, len(seg.keys))
+			for j, leaf := range entry.Children[:len(seg.keys)] {
+				names[j] = leaf.Name
+			}
+			b.mu.Lock()
+			b.keys[id] = names
+			b.mu.Unlock()
+			break
+		}
+	}
+}
+
+// listID identifies the list of the last segment by the schema path of segments.
+func listID(segments []segment) string {
+	parts := make([]string, len(segments))
+	for i, seg := range segments {
+		parts[i] = seg.ns + " " + seg.name
+	}
+	return strings.Join(parts, "/")
+}
+
+// selectNodes walks nodes along segments and returns the addressed nodes. List entries are
+// matched by their leading leaves, which RFC 7950 requires to be the keys in order.
+func selectNodes(nodes []*Node, segments []segment) []*Node {
+	current := nodes
+	for i, seg := range segments {
+		var matched []*Node
+		for _, node := range current {
+			if node.Name.Local == seg.name && node.Name.Space == seg.ns && (!seg.keyed || matchesKeys(node, seg.keys)) {
+				matched = append(matched, node)
+			}
+		}
+		if i == len(segments)-1 {
+			return matched
+		}
+		current = nil
+		for _, node := range matched {
+			current = append(current, node.Children...)
+		}
+	}
+	return nil
+}
+
+// matchesKeys reports whether the leading leaves of entry hold keys.
+func matchesKeys(entry *Node, keys []string) bool {
+	if len(entry.Children) < len(keys) {
+		return false
+	}
+	for i, key := range keys {
+		leaf := entry.Children[i]
+		if len(leaf.Children) > 0 || leaf.Text != key {
+			return false
+		}
+	}
+	return true
+}
+
+// setOperation sets the edit-config operation attribute on nodes.
+func setOperation(nodes []*Node, operation string) {
+	for _, node := range nodes {
+		attr := xml.Attr{Name: xml.Name{Space: BaseNamespace, Local: operationAttr}, Value: operation}
+		node.Attrs = append(node.Attrs, attr)
+	}
+}
+
+// baseNode returns a NETCONF protocol element with children.
+func baseNode(local string, children ...*Node) *Node {
+	return &Node{Name: xml.Name{Space: BaseNamespace, Local: local}, Children: children}
+}
+
+// dataNode wraps nodes in the <data> element of reply bodies.
+func dataNode(nodes ...*Node) *Node {
+	return baseNode("data", nodes...)
+}
+
+// notFound returns the error for a missing resource.
+func notFound(path string) *Error {
+	return &Error{Status: http.StatusNotFound, Message: "NETCONF error: resource not found: " + path}
+}
+
+// badRequest returns the error for a call that cannot be mapped to NETCONF.
+func badRequest(message string) *Error {
+	return &Error{Status: http.StatusBadRequest, Message: "NETCONF error: " + message}
+}
@@ -0,0 +1,174 @@
+package netconf
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// rfTagsReply is the running configuration of two rf-tag entries.
+const rfTagsReply = `<data><rf-cfg-data xmlns="` + rfCfgNamespace + `"><rf-tags>` +
+	`<rf-tag><tag-name>a b</tag-name><description>first</description></rf-tag>` +
+	`<rf-tag><tag-name>second</tag-name></rf-tag>` +
+	`</rf-tags></rf-cfg-data></data>`
+
+// newTestBackend returns a backend connected to a stand-in server answering with handler.
+func newTestBackend(t *testing.T, handler testutil.NETCONFHandler) (*Backend, *testutil.NETCONFServer) {
+	t.Helper()
+	server := testutil.NewNETCONFServer(t, handler)
+	backend := NewBackend(server.Port, func(context.Context) (Config, error) {
+		return Config{
+			Username:        testutil.NETCONFUsername,
+			Password:        testutil.NETCONFPassword,
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		}, nil
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	return backend, server
+}
+
+func TestNETCONFBackendUnit_Get(t *testing.T) {
+	backend, server := newTestBackend(t, func(string) string { return rfTagsReply })
+	path := routes.RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags/rf-tag=a%20b"
+
+	status, body, err := backend.Do(context.Background(), "127.0.0.1", Call{Method: http.MethodGet, Path: path})
+	testutil.AssertNoError(t, err, "Do")
+	testutil.AssertIntEquals(t, status, http.StatusOK, "status")
+	testutil.AssertStringContains(t, string(body), "<tag-name>a b</tag-name>", "selected entry")
+	testutil.AssertFalse(t, strings.Contains(string(body), "second"), "other entries filtered out")
+
+	request := server.LastRequest()
+	testutil.AssertStringContains(t, request, "<get-config><source><running/></source>", "cfg module uses get-config")
+	testutil.AssertStringContains(t, request, `<filter type="subtree">`, "subtree filter")
+	testutil.AssertStringContains(t, request, "<rf-tags><rf-tag/></rf-tags>", "unknown key leaves")
+
+	_, _, err = backend.Do(context.Background(), "127.0.0.1",
+		Call{Method: http.MethodGet, Path: path + "?content=nonconfig"})
+	testutil.AssertNoError(t, err, "Do nonconfig")
+	request = server.LastRequest()
+	testutil.AssertStringContains(t, request, "<get>", "content=nonconfig uses get")
+	testutil.AssertStringContains(t, request, "<rf-tags><rf-tag><tag-name>a b</tag-name></rf-tag></rf-tags>",
+		"learned key leaves as content-match nodes")
+
+	_, _, err = backend.Do(context.Background(), "127.0.0.1",
+		Call{Method: http.MethodGet, Path: strings.TrimSuffix(path, "a%20b") + "missing"})
+	var ncErr *Error
+	testutil.AssertTrue(t, errors.As(err, &ncErr), "missing entry returns *Error")
+	testutil.AssertIntEquals(t, ncErr.StatusCode(), http.StatusNotFound, "missing entry status")
+}
+
+func TestNETCONFBackendUnit_Edit(t *testing.T) {
+	backend, server := newTestBackend(t, func(rpc string) string {
+		if strings.Contains(rpc, "<edit-config>") {
+			return "<ok/>"
+		}
+		return rfTagsReply
+	})
+	path := routes.RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags/rf-tag=second"
+
+	tests := []struct {
+		method    string
+		payload   string
+		status    int
+		operation string
+	}{
+		{http.MethodPatch, `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tag":[{"tag-name":"second","description":"x"}]}`,
+			http.StatusNoContent, ""},
+		{http.MethodPut, `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tag":[{"tag-name":"second"}]}`,
+			http.StatusNoContent, `nc:operation="replace"`},
+		{http.MethodDelete, "", http.StatusNoContent, `nc:operation="delete"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			call := Call{Method: tt.method, Path: path}
+			if tt.payload != "" {
+				call.Payload = []byte(tt.payload)
+			}
+			status, _, err := backend.Do(context.Background(), "127.0.0.1", call)
+			testutil.AssertNoError(t, err, "Do")
+			testutil.AssertIntEquals(t, status, tt.status, "status")
+
+			request := server.LastRequest()
+			testutil.AssertStringContains(t, request, "<edit-config><target><running/></target>", "edit-config")
+			testutil.AssertStringContains(t, request, "<rf-cfg-data xmlns=\""+rfCfgNamespace+"\"><rf-tags>",
+				"parents rebuilt from the path")
+			testutil.AssertStringContains(t, request, "<tag-name>second</tag-name>", "key leaf")
+			if tt.operation != "" {
+				testutil.AssertStringContains(t, request, tt.operation, "operation attribute")
+			}
+		})
+	}
+
+	_, _, err := backend.Do(context.Background(), "127.0.0.1", Call{Method: http.MethodPatch, Path: path})
+	testutil.AssertErrorContains(t, err, "payload is required", "missing payload")
+}
+
+func TestNETCONFBackendUnit_RPCAndErrors(t *testing.T) {
+	backend, server := newTestBackend(t, func(rpc string) string {
+		switch {
+		case strings.Contains(rpc, "<set-ap-admin-state"):
+			return `<rpc-error><error-type>application</error-type><error-tag>data-missing</error-tag>` +
+				`<error-severity>error</error-severity><error-message>unknown AP</error-message></rpc-error>`
+		case strings.Contains(rpc, "<ap-reset"):
+			return `<result xmlns="http://cisco.com/ns/yang/Cisco-IOS-XE-wireless-access-point-cmd-rpc">done</result>`
+		default:
+			return "<ok/>"
+		}
+	})
+	module := routes.RESTCONFOperationsPath + "/Cisco-IOS-XE-wireless-access-point-cmd-rpc:"
+
+	status, body, err := backend.Do(context.Background(), "127.0.0.1", Call{
+		Method:  http.MethodPost,
+		Path:    module + "ap-reset",
+		Payload: []byte(`{"Cisco-IOS-XE-wireless-access-point-cmd-rpc:input":{"mac-addr":"aa:bb:cc:dd:ee:ff"}}`),
+		RPC:     true,
+	})
+	testutil.AssertNoError(t, err, "RPC")
+	testutil.AssertIntEquals(t, status, http.StatusOK, "RPC with output")
+	testutil.AssertStringContains(t, string(body), "<output", "output wrapper")
+	testutil.AssertStringContains(t, server.LastRequest(), "<mac-addr>aa:bb:cc:dd:ee:ff</mac-addr>", "input unwrapped")
+
+	_, _, err = backend.Do(context.Background(), "127.0.0.1",
+		Call{Method: http.MethodPost, Path: module + "set-ap-admin-state", RPC: true})
+	var ncErr *Error
+	testutil.AssertTrue(t, errors.As(err, &ncErr), "rpc-error returns *Error")
+	testutil.AssertIntEquals(t, ncErr.StatusCode(), http.StatusConflict, "data-missing status")
+	testutil.AssertStringEquals(t, ncErr.Errors[0].Message, "unknown AP", "error message")
+}
+
+func TestNETCONFSessionUnit_Dial(t *testing.T) {
+	server := testutil.NewNETCONFServer(t, func(string) string { return "<ok/>" })
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(server.Port))
+
+	session, err := Dial(context.Background(), address, Config{
+		Username:        testutil.NETCONFUsername,
+		Password:        testutil.NETCONFPassword,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	testutil.AssertNoError(t, err, "Dial")
+	testutil.AssertStringEquals(t, session.SessionID(), "1", "session id from hello")
+	testutil.AssertTrue(t, slices.Contains(session.Capabilities(), CapabilityBase11), "base:1.1 announced")
+	testutil.AssertNoError(t, session.Close(), "Close")
+	testutil.AssertTrue(t, session.Closed(), "closed after Close")
+
+	_, err = Dial(context.Background(), address, Config{
+		Username:        testutil.NETCONFUsername,
+		Password:        "wrong",
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	var authErr *AuthError
+	testutil.AssertTrue(t, errors.As(err, &authErr), "wrong password returns *AuthError")
+
+	_, err = Dial(context.Background(), address, Config{Username: testutil.NETCONFUsername})
+	testutil.AssertErrorContains(t, err, "host key callback is required", "missing host key callback")
+}
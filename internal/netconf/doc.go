@@ -0,0 +1,7 @@
+// Package netconf carries RESTCONF-style calls over NETCONF sessions (RFC 6241) on SSH (RFC 6242).
+//
+// Contains Session for the NETCONF protocol exchange with 1.0 and 1.1 framing, Backend for mapping
+// RESTCONF data and operations paths onto <get>, <get-config>, <edit-config> and RPCs, and the
+// conversion between RFC 7951 JSON documents and their RFC 7950 XML encoding. XML replies are decoded
+// into the existing typed structs by ToJSON, which uses the Go type as the schema.
+package netconf
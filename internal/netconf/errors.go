@@ -0,0 +1,105 @@
+package netconf
+
+import (
+	"net/http"
+	"strings"
+)
+
+// RPCError is an <rpc-error> of a NETCONF reply (RFC 6241 section 4.3).
+type RPCError struct {
+	Type     string // transport, rpc, protocol or application
+	Tag      string // Error condition such as data-exists
+	Severity string // error or warning
+	AppTag   string // Data model or implementation specific condition
+	Path     string // Instance identifier of the offending node
+	Message  string // Human readable description
+	Info     string // Additional content rendered as XML
+}
+
+// Error reports NETCONF errors together with the HTTP status RESTCONF uses for them.
+type Error struct {
+	Status  int        // HTTP status code (RFC 8040 section 7)
+	Errors  []RPCError // Errors reported by the server
+	Message string     // Description for errors raised by the client
+}
+
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	descriptions := make([]string, len(e.Errors))
+	for i, rpcErr := range e.Errors {
+		descriptions[i] = rpcErr.Tag
+		if rpcErr.Message != "" {
+			descriptions[i] += ": " + rpcErr.Message
+		}
+	}
+	return "NETCONF error: " + strings.Join(descriptions, "; ")
+}
+
+// StatusCode returns the HTTP status of the error, derived from the first error-tag when unset.
+func (e *Error) StatusCode() int {
+	if e.Status != 0 {
+		return e.Status
+	}
+	if len(e.Errors) > 0 {
+		return statusForTag(e.Errors[0].Tag)
+	}
+	return http.StatusInternalServerError
+}
+
+// statusForTag maps a NETCONF error-tag to the HTTP status of RFC 8040 section 7.
+func statusForTag(tag string) int {
+	switch tag {
+	case "in-use", "lock-denied", "resource-denied", "data-exists", "data-missing":
+		return http.StatusConflict
+	case "invalid-value", "missing-attribute", "bad-attribute", "unknown-attribute",
+		"bad-element", "unknown-element", "unknown-namespace", "malformed-message":
+		return http.StatusBadRequest
+	case "too-big":
+		return http.StatusRequestEntityTooLarge
+	case "access-denied":
+		return http.StatusForbidden
+	case "operation-not-supported":
+		return http.StatusMethodNotAllowed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseRPCErrors returns the <rpc-error> entries of a reply with severity error.
+func parseRPCErrors(reply *Node) []RPCError {
+	var errs []RPCError
+	for _, child := range reply.Children {
+		if child.Name.Local != "rpc-error" {
+			continue
+		}
+		rpcErr := RPCError{
+			Type:     childText(child, "error-type"),
+			Tag:      childText(child, "error-tag"),
+			Severity: childText(child, "error-severity"),
+			AppTag:   childText(child, "error-app-tag"),
+			Path:     childText(child, "error-path"),
+			Message:  childText(child, "error-message"),
+		}
+		if info := child.Child("error-info"); info != nil {
+			var sb strings.Builder
+			for _, node := range info.Children {
+				sb.Write(node.Marshal())
+			}
+			rpcErr.Info = sb.String()
+		}
+		if rpcErr.Severity != "warning" {
+			errs = append(errs, rpcErr)
+		}
+	}
+	return errs
+}
+
+// childText returns the text of the first child named local.
+func childText(n *Node, local string) string {
+	if child := n.Child(local); child != nil {
+		return child.Text
+	}
+	return ""
+}
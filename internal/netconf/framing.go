@@ -0,0 +1,115 @@
+package netconf
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Framing constants (RFC 6242 section 4).
+const (
+	// endOfMessage terminates messages in NETCONF 1.0 framing and the hello exchange.
+	endOfMessage = "]]>]]>"
+	// maxMessageSize bounds the size of a received message.
+	maxMessageSize = 64 << 20
+)
+
+// framer reads and writes NETCONF messages with end-of-message or chunked framing.
+type framer struct {
+	r       *bufio.Reader
+	w       io.Writer
+	chunked bool // Chunked framing, used once both peers announced base:1.1
+}
+
+// newFramer returns a framer using end-of-message framing.
+func newFramer(r io.Reader, w io.Writer) *framer {
+	return &framer{r: bufio.NewReader(r), w: w}
+}
+
+// writeMessage writes a complete message.
+func (f *framer) writeMessage(msg []byte) error {
+	var buf bytes.Buffer
+	if f.chunked {
+		fmt.Fprintf(&buf, "\n#%d\n", len(msg))
+		buf.Write(msg)
+		buf.WriteString("\n##\n")
+	} else {
+		buf.Write(msg)
+		buf.WriteString(endOfMessage)
+	}
+	_, err := f.w.Write(buf.Bytes())
+	return err
+}
+
+// readMessage reads a complete message.
+func (f *framer) readMessage() ([]byte, error) {
+	if f.chunked {
+		return f.readChunked()
+	}
+	return f.readEndOfMessage()
+}
+
+// readEndOfMessage reads up to the end-of-message marker.
+func (f *framer) readEndOfMessage() ([]byte, error) {
+	var msg []byte
+	for {
+		part, err := f.r.ReadSlice('>')
+		msg = append(msg, part...)
+		if bytes.HasSuffix(msg, []byte(endOfMessage)) {
+			return msg[:len(msg)-len(endOfMessage)], nil
+		}
+		if len(msg) > maxMessageSize {
+			return nil, errors.New("message exceeds maximum size")
+		}
+		if err != nil && !errors.Is(err, bufio.ErrBufferFull) {
+			return nil, err
+		}
+	}
+}
+
+// readChunked reads chunks up to the end-of-chunks marker.
+func (f *framer) readChunked() ([]byte, error) {
+	var msg []byte
+	for {
+		if err := f.expect("\n#"); err != nil {
+			return nil, err
+		}
+		header, err := f.r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		header = header[:len(header)-1]
+		if header == "#" {
+			return msg, nil
+		}
+		size, err := strconv.ParseUint(header, 10, 32)
+		if err != nil || size == 0 {
+			return nil, fmt.Errorf("invalid chunk header %q", header)
+		}
+		if len(msg)+int(size) > maxMessageSize {
+			return nil, errors.New("message exceeds maximum size")
+		}
+		chunk := make([]byte, size)
+		if _, err := io.ReadFull(f.r, chunk); err != nil {
+			return nil, err
+		}
+		msg = append(msg, chunk...)
+	}
+}
+
+// expect consumes the literal s.
+func (f *framer) expect(s string) error {
+	for i := range len(s) {
+		c, err := f.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if c != s[i] {
+			return fmt.Errorf("invalid chunk framing: unexpected byte %q", c)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package netconf
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// rawMessageType is the reflect type of json.RawMessage.
+var rawMessageType = reflect.TypeFor[json.RawMessage]()
+
+// FromJSON converts an RFC 7951 JSON document into XML elements, one per top-level member and
+// list entry. Members are emitted in document order, so list keys stay first as RFC 7950 requires.
+func FromJSON(document []byte) ([]*Node, error) {
+	dec := json.NewDecoder(bytes.NewReader(document))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert JSON to XML: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, errors.New("failed to convert JSON to XML: document must be an object")
+	}
+	root := &Node{}
+	if err := decodeMembers(dec, root); err != nil {
+		return nil, fmt.Errorf("failed to convert JSON to XML: %w", err)
+	}
+	return root.Children, nil
+}
+
+// decodeMembers appends the members of the current JSON object to parent and consumes its end.
+func decodeMembers(dec *json.Decoder, parent *Node) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		member, _ := tok.(string)
+		name := xml.Name{Space: parent.Name.Space, Local: member}
+		if module, local, qualified := strings.Cut(member, ":"); qualified {
+			name = xml.Name{Space: Namespace(module), Local: local}
+		}
+		nodes, err := decodeValue(dec, name)
+		if err != nil {
+			return err
+		}
+		parent.Children = append(parent.Children, nodes...)
+	}
+	_, err := dec.Token() // Closing '}'
+	return err
+}
+
+// decodeValue converts the next JSON value into elements named name.
+func decodeValue(dec *json.Decoder, name xml.Name) ([]*Node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	node := &Node{Name: name}
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '{' {
+			return []*Node{node}, decodeMembers(dec, node)
+		}
+		// Lists and leaf-lists become repeated elements; [null] is the empty type
+		var nodes []*Node
+		for dec.More() {
+			entries, err := decodeValue(dec, name)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, entries...)
+		}
+		_, err := dec.Token() // Closing ']'
+		return nodes, err
+	case string:
+		node.Text = t
+	case json.Number:
+		node.Text = t.String()
+	case bool:
+		node.Text = strconv.FormatBool(t)
+	}
+	return []*Node{node}, nil
+}
+
+// ToJSON renders XML elements as the JSON document of a value of type t. The Go type supplies
+// what the XML encoding does not carry: which elements are lists and which leaves are numbers or
+// booleans. Members without a counterpart in t are converted without type information.
+func ToJSON(nodes []*Node, t reflect.Type) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeContainer(&buf, nodes, "", t); err != nil {
+		return nil, fmt.Errorf("failed to convert XML to JSON: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeContainer writes nodes as a JSON object of type t. parentNS decides which member names
+// are module-qualified when t gives no names.
+func writeContainer(buf *bytes.Buffer, nodes []*Node, parentNS string, t reflect.Type) error {
+	t = indirect(t)
+	if t.Kind() != reflect.Struct {
+		writeUntyped(buf, nodes, parentNS)
+		return nil
+	}
+
+	buf.WriteByte('{')
+	first := true
+	for _, field := range jsonFields(t) {
+		_, local, qualified := strings.Cut(field.name, ":")
+		if !qualified {
+			local = field.name
+		}
+		var matches []*Node
+		for _, node := range nodes {
+			if node.Name.Local == local {
+				matches = append(matches, node)
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		writeString(buf, field.name)
+		buf.WriteByte(':')
+
+		ft := indirect(field.typ)
+		if ft.Kind() == reflect.Slice && ft != rawMessageType {
+			buf.WriteByte('[')
+			for i, node := range matches {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if ft.Elem().Kind() == reflect.Interface && len(node.Children) == 0 && node.Text == "" {
+					// Empty type leaf, encoded as [null]
+					buf.WriteString("null")
+					continue
+				}
+				if err := writeValue(buf, node, ft.Elem()); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+			continue
+		}
+		if err := writeValue(buf, matches[0], ft); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeValue writes a single element as a JSON value of type t.
+func writeValue(buf *bytes.Buffer, node *Node, t reflect.Type) error {
+	t = indirect(t)
+	if t == rawMessageType || t.Kind() == reflect.Interface {
+		writeUntypedNode(buf, node)
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return writeContainer(buf, node.Children, node.Name.Space, t)
+	case reflect.Slice:
+		buf.WriteByte('[')
+		if err := writeValue(buf, node, t.Elem()); err != nil {
+			return err
+		}
+		buf.WriteByte(']')
+	case reflect.Bool:
+		if _, err := strconv.ParseBool(node.Text); err != nil {
+			return fmt.Errorf("leaf %s: %q is not a boolean", node.Name.Local, node.Text)
+		}
+		buf.WriteString(node.Text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if _, err := strconv.ParseFloat(node.Text, 64); err != nil {
+			return fmt.Errorf("leaf %s: %q is not a number", node.Name.Local, node.Text)
+		}
+		buf.WriteString(node.Text)
+	default:
+		writeString(buf, node.Text)
+	}
+	return nil
+}
+
+// writeUntyped writes nodes as a JSON object, using arrays for repeated elements and
+// module-qualified names where the namespace changes.
+func writeUntyped(buf *bytes.Buffer, nodes []*Node, parentNS string) {
+	var order []string
+	groups := map[string][]*Node{}
+	for _, node := range nodes {
+		name := node.Name.Local
+		if node.Name.Space != parentNS {
+			if module := ModuleOf(node.Name.Space); module != "" {
+				name = module + ":" + name
+			}
+		}
+		if _, seen := groups[name]; !seen {
+			order = append(order, name)
+		}
+		groups[name] = append(groups[name], node)
+	}
+
+	buf.WriteByte('{')
+	for i, name := range order {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeString(buf, name)
+		buf.WriteByte(':')
+		group := groups[name]
+		if len(group) == 1 {
+			writeUntypedNode(buf, group[0])
+			continue
+		}
+		buf.WriteByte('[')
+		for j, node := range group {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			writeUntypedNode(buf, node)
+		}
+		buf.WriteByte(']')
+	}
+	buf.WriteByte('}')
+}
+
+// writeUntypedNode writes a single element without type information. Leaves become strings
+// and empty leaves the RFC 7951 empty value [null].
+func writeUntypedNode(buf *bytes.Buffer, node *Node) {
+	switch {
+	case len(node.Children) > 0:
+		writeUntyped(buf, node.Children, node.Name.Space)
+	case node.Text == "":
+		buf.WriteString("[null]")
+	default:
+		writeString(buf, node.Text)
+	}
+}
+
+// writeString writes s as a JSON string.
+func writeString(buf *bytes.Buffer, s string) {
+	data, _ := json.Marshal(s)
+	buf.Write(data)
+}
+
+// jsonField is a struct field as seen by encoding/json.
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// jsonFields returns the JSON member names and types of struct type t, including promoted fields.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && indirect(field.Type).Kind() == reflect.Struct {
+			fields = append(fields, jsonFields(indirect(field.Type))...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, jsonField{name: name, typ: field.Type})
+	}
+	return fields
+}
+
+// indirect returns the element type of pointer types.
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
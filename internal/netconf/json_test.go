@@ -0,0 +1,133 @@
+package netconf
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+const rfCfgNamespace = "http://cisco.com/ns/yang/Cisco-IOS-XE-wireless-rf-cfg"
+
+// rfTag mirrors the shape of the service structs: keys first, numbers, booleans and empty leaves.
+type rfTag struct {
+	TagName     string `json:"tag-name"`
+	Description string `json:"description,omitempty"`
+	Priority    *int   `json:"priority,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	Flags       []any  `json:"flag,omitempty"`
+	Channels    []int  `json:"channel,omitempty"`
+	Band        string `json:"band,omitempty"`
+}
+
+// rfTagDocument is the response document of an rf-tag list.
+type rfTagDocument struct {
+	RFTags []rfTag `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-tag"`
+}
+
+func TestNETCONFJSONUnit_FromJSON(t *testing.T) {
+	document := `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tag":[
+		{"tag-name":"a<b","priority":3,"enabled":true,"flag":[null],"channel":[1,6],
+		 "band":"Cisco-IOS-XE-wireless-enum-types:dot11-5-ghz-band"},
+		{"tag-name":"second"}]}`
+
+	nodes, err := FromJSON([]byte(document))
+	testutil.AssertNoError(t, err, "FromJSON")
+	testutil.AssertIntEquals(t, len(nodes), 2, "one element per list entry")
+	testutil.AssertStringEquals(t, nodes[0].Name.Space, rfCfgNamespace, "module namespace")
+	testutil.AssertStringEquals(t, nodes[0].Children[0].Name.Local, "tag-name", "keys stay first")
+	testutil.AssertStringEquals(t, nodes[0].Children[0].Name.Space, rfCfgNamespace, "inherited namespace")
+
+	xml := string(nodes[0].Marshal())
+	for _, want := range []string{
+		`<rf-tag xmlns="` + rfCfgNamespace + `"><tag-name>a&lt;b</tag-name>`,
+		`<priority>3</priority><enabled>true</enabled><flag/><channel>1</channel><channel>6</channel>`,
+		`<band xmlns:Cisco-IOS-XE-wireless-enum-types="http://cisco.com/ns/yang/Cisco-IOS-XE-wireless-enum-types">` +
+			`Cisco-IOS-XE-wireless-enum-types:dot11-5-ghz-band</band>`,
+	} {
+		testutil.AssertStringContains(t, xml, want, "XML encoding")
+	}
+
+	_, err = FromJSON([]byte(`[1]`))
+	testutil.AssertError(t, err, "non-object document")
+}
+
+func TestNETCONFJSONUnit_ToJSON_Typed(t *testing.T) {
+	reply := `<data xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<rf-tag xmlns="` + rfCfgNamespace + `" xmlns:types="http://cisco.com/ns/yang/Cisco-IOS-XE-wireless-enum-types">
+			<tag-name>001</tag-name><priority>3</priority><enabled>false</enabled><flag/>
+			<channel>11</channel><band>types:dot11-2-dot-4-ghz-band</band><unknown><x>1</x><x>2</x></unknown>
+		</rf-tag>
+	</data>`
+	root, err := Parse([]byte(reply))
+	testutil.AssertNoError(t, err, "Parse")
+
+	data, err := ToJSON(root.Children, reflect.TypeFor[rfTagDocument]())
+	testutil.AssertNoError(t, err, "ToJSON")
+	var doc rfTagDocument
+	testutil.AssertNoError(t, json.Unmarshal(data, &doc), "decode "+string(data))
+
+	testutil.AssertIntEquals(t, len(doc.RFTags), 1, "single entry decoded as a list")
+	tag := doc.RFTags[0]
+	testutil.AssertStringEquals(t, tag.TagName, "001", "numeric-looking string stays a string")
+	testutil.AssertIntEquals(t, *tag.Priority, 3, "number")
+	testutil.AssertFalse(t, tag.Enabled, "boolean")
+	testutil.AssertIntEquals(t, len(tag.Flags), 1, "empty leaf")
+	testutil.AssertIntEquals(t, len(tag.Channels), 1, "single leaf-list value")
+	testutil.AssertStringEquals(t, tag.Band, "Cisco-IOS-XE-wireless-enum-types:dot11-2-dot-4-ghz-band",
+		"identity prefix resolved to module")
+
+	_, err = ToJSON(root.Children[0].Children[1:2], reflect.TypeFor[struct {
+		Priority bool `json:"priority"`
+	}]())
+	testutil.AssertErrorContains(t, err, "not a boolean", "type mismatch")
+}
+
+func TestNETCONFJSONUnit_ToJSON_Untyped(t *testing.T) {
+	root, err := Parse([]byte(`<data><output xmlns="` + rfCfgNamespace + `">
+		<result>done</result><item>a</item><item>b</item><flag/>
+		<ext xmlns="urn:ietf:params:xml:ns:yang:ietf-x"><v>1</v></ext></output></data>`))
+	testutil.AssertNoError(t, err, "Parse")
+
+	data, err := ToJSON(root.Children, reflect.TypeFor[map[string]any]())
+	testutil.AssertNoError(t, err, "ToJSON")
+	got := string(data)
+	testutil.AssertStringEquals(t, got,
+		`{"Cisco-IOS-XE-wireless-rf-cfg:output":{"result":"done","item":["a","b"],"flag":[null],"ietf-x:ext":{"v":"1"}}}`,
+		"untyped document")
+}
+
+func TestNETCONFJSONUnit_RoundTrip(t *testing.T) {
+	priority := 7
+	want := rfTagDocument{RFTags: []rfTag{
+		{TagName: "site/floor 1,east", Priority: &priority, Enabled: true, Channels: []int{36, 40}},
+		{TagName: "b"},
+	}}
+	document, _ := json.Marshal(want)
+	nodes, err := FromJSON(document)
+	testutil.AssertNoError(t, err, "FromJSON")
+
+	var sb strings.Builder
+	for _, node := range nodes {
+		sb.Write(node.Marshal())
+	}
+	root, err := Parse([]byte("<data>" + sb.String() + "</data>"))
+	testutil.AssertNoError(t, err, "Parse")
+	data, err := ToJSON(root.Children, reflect.TypeFor[rfTagDocument]())
+	testutil.AssertNoError(t, err, "ToJSON")
+
+	var got rfTagDocument
+	testutil.AssertNoError(t, json.Unmarshal(data, &got), "decode")
+	testutil.AssertTrue(t, reflect.DeepEqual(got, want), "round trip: "+string(data))
+}
+
+func TestNETCONFXMLUnit_Parse_Failure(t *testing.T) {
+	for _, data := range []string{"", "<a>", "text only", "<a></b>"} {
+		_, err := Parse([]byte(data))
+		testutil.AssertError(t, err, "Parse("+data+")")
+	}
+	testutil.AssertStringEquals(t, ModuleOf("urn:ietf:params:xml:ns:netconf:base:1.0"), "", "base namespace")
+	testutil.AssertStringEquals(t, ModuleOf(Namespace("ietf-interfaces")), "ietf-interfaces", "ietf module")
+}
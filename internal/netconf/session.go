@@ -0,0 +1,249 @@
+package netconf
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Session constants.
+const (
+	// DefaultPort is the NETCONF over SSH port (RFC 6242).
+	DefaultPort = 830
+	// DefaultTimeout bounds connection setup including the hello exchange.
+	DefaultTimeout = 30 * time.Second
+	// CapabilityBase10 announces NETCONF 1.0 with end-of-message framing.
+	CapabilityBase10 = "urn:ietf:params:netconf:base:1.0"
+	// CapabilityBase11 announces NETCONF 1.1 with chunked framing.
+	CapabilityBase11 = "urn:ietf:params:netconf:base:1.1"
+	// sshSubsystem is the SSH subsystem carrying NETCONF.
+	sshSubsystem = "netconf"
+)
+
+// Config holds the SSH parameters of NETCONF sessions.
+type Config struct {
+	Username        string              // SSH user name
+	Password        string              // SSH password
+	HostKeyCallback ssh.HostKeyCallback // Host key verification, required
+	Timeout         time.Duration       // Connection setup timeout, DefaultTimeout when zero
+}
+
+// Session is a NETCONF session. RPCs are serialized; a session whose exchange was interrupted
+// is closed, since the message stream cannot be resynchronized.
+type Session struct {
+	mu           sync.Mutex
+	conn         io.Closer // Underlying connection closed with the session
+	framer       *framer
+	capabilities []string
+	sessionID    string
+	messageID    uint64
+	closed       bool
+}
+
+// Dial connects to the NETCONF SSH subsystem at address ("host:port") and exchanges hellos.
+func Dial(ctx context.Context, address string, cfg Config) (*Session, error) {
+	if cfg.HostKeyCallback == nil {
+		return nil, errors.New("netconf configuration failed: host key callback is required")
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NETCONF server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, address, &ssh.ClientConfig{
+		User:            cfg.Username,
+		Auth:            []ssh.AuthMethod{ssh.Password(cfg.Password)},
+		HostKeyCallback: cfg.HostKeyCallback,
+		Timeout:         timeout,
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, &AuthError{Err: err}
+	}
+	client := ssh.NewClient(sshConn, chans, reqs)
+
+	sshSession, err := client.NewSession()
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to open SSH session: %w", err)
+	}
+	stdin, err := sshSession.StdinPipe()
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to open SSH session: %w", err)
+	}
+	stdout, err := sshSession.StdoutPipe()
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to open SSH session: %w", err)
+	}
+	if err := sshSession.RequestSubsystem(sshSubsystem); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to start NETCONF subsystem: %w", err)
+	}
+
+	session, err := newSession(stdout, stdin, client)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return session, nil
+}
+
+// AuthError reports that the SSH server rejected the connection or the credentials.
+type AuthError struct {
+	Err error
+}
+
+func (e *AuthError) Error() string { return "NETCONF SSH authentication failed: " + e.Err.Error() }
+
+// Unwrap returns the underlying SSH error.
+func (e *AuthError) Unwrap() error { return e.Err }
+
+// newSession exchanges hellos over r and w and returns the session. conn is closed with the session.
+func newSession(r io.Reader, w io.Writer, conn io.Closer) (*Session, error) {
+	s := &Session{conn: conn, framer: newFramer(r, w)}
+	hello := `<hello xmlns="` + BaseNamespace + `"><capabilities>` +
+		`<capability>` + CapabilityBase10 + `</capability>` +
+		`<capability>` + CapabilityBase11 + `</capability>` +
+		`</capabilities></hello>`
+	if err := s.framer.writeMessage([]byte(hello)); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to send NETCONF hello: %w", err)
+	}
+
+	msg, err := s.framer.readMessage()
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to receive NETCONF hello: %w", err)
+	}
+	serverHello, err := Parse(msg)
+	if err != nil || serverHello.Name.Local != "hello" {
+		_ = conn.Close()
+		return nil, errors.New("failed to receive NETCONF hello: unexpected message")
+	}
+	if caps := serverHello.Child("capabilities"); caps != nil {
+		for _, capability := range caps.Children {
+			s.capabilities = append(s.capabilities, capability.Text)
+		}
+	}
+	if id := serverHello.Child("session-id"); id != nil {
+		s.sessionID = id.Text
+	}
+	s.framer.chunked = slices.Contains(s.capabilities, CapabilityBase11)
+	return s, nil
+}
+
+// Capabilities returns the capabilities announced by the server.
+func (s *Session) Capabilities() []string {
+	return slices.Clone(s.capabilities)
+}
+
+// SessionID returns the session identifier assigned by the server.
+func (s *Session) SessionID() string {
+	return s.sessionID
+}
+
+// Exec sends operation in an <rpc> and returns the <rpc-reply>. When the reply carries
+// <rpc-error> elements, the reply and an *Error are returned.
+func (s *Session) Exec(ctx context.Context, operation *Node) (*Node, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, errors.New("NETCONF session is closed")
+	}
+
+	s.messageID++
+	rpc := &Node{
+		Name:     xml.Name{Space: BaseNamespace, Local: "rpc"},
+		Attrs:    []xml.Attr{{Name: xml.Name{Local: "message-id"}, Value: strconv.FormatUint(s.messageID, 10)}},
+		Children: []*Node{operation},
+	}
+
+	// Interrupting a pending exchange leaves the stream unusable, so the session is closed
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = s.conn.Close()
+		case <-done:
+		}
+	}()
+
+	reply, err := s.exchange(rpc.Marshal())
+	if err != nil {
+		s.closed = true
+		_ = s.conn.Close()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	if errs := parseRPCErrors(reply); len(errs) > 0 {
+		return reply, &Error{Errors: errs}
+	}
+	return reply, nil
+}
+
+// exchange writes msg and reads the reply.
+func (s *Session) exchange(msg []byte) (*Node, error) {
+	if err := s.framer.writeMessage(msg); err != nil {
+		return nil, fmt.Errorf("failed to send NETCONF request: %w", err)
+	}
+	data, err := s.framer.readMessage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive NETCONF reply: %w", err)
+	}
+	reply, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Name.Local != "rpc-reply" {
+		return nil, fmt.Errorf("failed to receive NETCONF reply: unexpected <%s>", reply.Name.Local)
+	}
+	return reply, nil
+}
+
+// Closed reports whether the session can no longer be used.
+func (s *Session) Closed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// Close ends the session with <close-session> and closes the connection.
+func (s *Session) Close() error {
+	if s.Closed() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, _ = s.Exec(ctx, &Node{Name: xml.Name{Space: BaseNamespace, Local: "close-session"}})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if err := s.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
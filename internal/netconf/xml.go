@@ -0,0 +1,192 @@
+package netconf
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NETCONF namespaces and attributes.
+const (
+	// BaseNamespace is the namespace of NETCONF protocol elements.
+	BaseNamespace = "urn:ietf:params:xml:ns:netconf:base:1.0"
+	// operationAttr is the edit-config operation attribute name in the base namespace.
+	operationAttr = "operation"
+)
+
+// YANG module namespace prefixes used by the controller.
+const (
+	ciscoNamespacePrefix = "http://cisco.com/ns/yang/"
+	ietfNamespacePrefix  = "urn:ietf:params:xml:ns:yang:"
+)
+
+// Node is an XML element with its namespace resolved.
+type Node struct {
+	Name     xml.Name   // Element name, Space holds the namespace URI
+	Attrs    []xml.Attr // Attributes other than namespace declarations
+	Children []*Node    // Child elements in document order
+	Text     string     // Trimmed character data of leaf elements
+}
+
+// Child returns the first child element with the given local name, or nil.
+func (n *Node) Child(local string) *Node {
+	for _, child := range n.Children {
+		if child.Name.Local == local {
+			return child
+		}
+	}
+	return nil
+}
+
+// Parse reads a single XML document into a node tree. Identity values written as "prefix:name"
+// are rewritten to the JSON form "module:name" when the prefix maps to a known YANG namespace.
+func Parse(data []byte) (*Node, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var (
+		stack  []*Node
+		scopes []map[string]string // In-scope prefix declarations per open element
+		root   *Node
+	)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			node := &Node{Name: t.Name}
+			prefixes := map[string]string{}
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "xmlns":
+					prefixes[attr.Name.Local] = attr.Value
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+				default:
+					node.Attrs = append(node.Attrs, attr)
+				}
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+			scopes = append(scopes, prefixes)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += string(t)
+			}
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, errors.New("failed to parse XML: unbalanced end element")
+			}
+			node := stack[len(stack)-1]
+			node.Text = resolveIdentity(strings.TrimSpace(node.Text), scopes)
+			if len(node.Children) > 0 {
+				node.Text = ""
+			}
+			stack, scopes = stack[:len(stack)-1], scopes[:len(scopes)-1]
+		}
+	}
+	if root == nil {
+		return nil, errors.New("failed to parse XML: document has no root element")
+	}
+	return root, nil
+}
+
+// resolveIdentity rewrites "prefix:name" to "module:name" when prefix is declared in scope.
+func resolveIdentity(text string, scopes []map[string]string) string {
+	prefix, name, ok := strings.Cut(text, ":")
+	if !ok || prefix == "" || name == "" || strings.ContainsAny(text, " \t\n/") {
+		return text
+	}
+	for i := len(scopes) - 1; i >= 0; i-- {
+		if namespace, declared := scopes[i][prefix]; declared {
+			if module := ModuleOf(namespace); module != "" {
+				return module + ":" + name
+			}
+			return text
+		}
+	}
+	return text
+}
+
+// Marshal returns the XML encoding of n. Namespaces are declared where they differ from the parent.
+func (n *Node) Marshal() []byte {
+	var buf bytes.Buffer
+	n.write(&buf, "")
+	return buf.Bytes()
+}
+
+// write encodes n into buf. parentNS is the default namespace in scope.
+func (n *Node) write(buf *bytes.Buffer, parentNS string) {
+	buf.WriteString("<" + n.Name.Local)
+	if n.Name.Space != parentNS {
+		buf.WriteString(` xmlns="`)
+		_ = xml.EscapeText(buf, []byte(n.Name.Space))
+		buf.WriteString(`"`)
+	}
+	for _, attr := range n.Attrs {
+		name := attr.Name.Local
+		if attr.Name.Space == BaseNamespace && n.Name.Space != BaseNamespace {
+			buf.WriteString(` xmlns:nc="` + BaseNamespace + `"`)
+			name = "nc:" + name
+		}
+		buf.WriteString(" " + name + `="`)
+		_ = xml.EscapeText(buf, []byte(attr.Value))
+		buf.WriteString(`"`)
+	}
+	if prefix, _, ok := identityPrefix(n.Text); ok {
+		// Identity values keep the module name as their XML prefix
+		buf.WriteString(` xmlns:` + prefix + `="` + Namespace(prefix) + `"`)
+	}
+	if len(n.Children) == 0 && n.Text == "" {
+		buf.WriteString("/>")
+		return
+	}
+	buf.WriteString(">")
+	_ = xml.EscapeText(buf, []byte(n.Text))
+	for _, child := range n.Children {
+		child.write(buf, n.Name.Space)
+	}
+	buf.WriteString("</" + n.Name.Local + ">")
+}
+
+// Namespace returns the XML namespace of a YANG module.
+func Namespace(module string) string {
+	if strings.HasPrefix(module, "ietf-") || strings.HasPrefix(module, "iana-") {
+		return ietfNamespacePrefix + module
+	}
+	return ciscoNamespacePrefix + module
+}
+
+// ModuleOf returns the YANG module of an XML namespace, or "" when it is not a module namespace.
+func ModuleOf(namespace string) string {
+	for _, prefix := range []string{ciscoNamespacePrefix, ietfNamespacePrefix} {
+		if module, ok := strings.CutPrefix(namespace, prefix); ok && module != "" && !strings.Contains(module, "/") {
+			return module
+		}
+	}
+	return ""
+}
+
+// identityPrefix reports whether value is an identity "module:name" of a known module family.
+func identityPrefix(value string) (module, name string, ok bool) {
+	module, name, ok = strings.Cut(value, ":")
+	if !ok || name == "" || strings.ContainsAny(name, ": /") {
+		return "", "", false
+	}
+	for _, family := range []string{"Cisco-IOS-XE-", "ietf-", "iana-"} {
+		if strings.HasPrefix(module, family) {
+			return module, name, true
+		}
+	}
+	return "", "", false
+}
@@ -2,7 +2,6 @@ package restconf
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
@@ -38,6 +37,11 @@ func NewBuilder(protocol, controller string) *Builder {
 	}
 }
 
+// Controller returns the controller address the builder targets.
+func (b *Builder) Controller() string {
+	return b.controller
+}
+
 // BuildDataURL constructs a RESTCONF data URL for the given endpoint path.
 func (b *Builder) BuildDataURL(endpointPath string) string {
 	if strings.HasPrefix(endpointPath, routes.RESTCONFDataPath) {
@@ -56,33 +60,51 @@ func (b *Builder) BuildOperationsURL(rpcPath string) string {
 	return fmt.Sprintf("%s%s%s", b.buildBaseURL(), routes.RESTCONFOperationsPath, normalizedOperationsPath)
 }
 
-// BuildQueryURL constructs URLs for queries with key-value parameters
-// Format: endpoint=identifier.
+// BuildQueryURL constructs URLs for list entries selected by a single key value.
+// Format: endpoint=identifier, with the key percent-encoded as in RFC 8040 section 3.5.3.
 func (b *Builder) BuildQueryURL(endpoint, identifier string) string {
-	return fmt.Sprintf("%s=%s", endpoint, identifier)
+	return endpoint + "=" + EscapeKey(identifier)
 }
 
-// BuildQueryCompositeURL constructs URLs for queries with composite parameters
-// Format: endpoint=value1,value2,value3...
+// BuildQueryCompositeURL constructs URLs for list entries selected by several key values.
+// Format: endpoint=value1,value2,value3..., with each key percent-encoded as in RFC 8040 section 3.5.3.
 func (b *Builder) BuildQueryCompositeURL(endpoint string, values ...interface{}) string {
-	strValues := make([]string, len(values))
+	keys := make([]string, len(values))
 	for i, v := range values {
-		switch val := v.(type) {
-		case string:
-			strValues[i] = val
-		case int:
-			strValues[i] = strconv.Itoa(val)
-		case int64:
-			strValues[i] = strconv.FormatInt(val, 10)
-		case float64:
-			strValues[i] = strconv.FormatFloat(val, 'f', -1, 64)
-		case bool:
-			strValues[i] = strconv.FormatBool(val)
-		default:
-			strValues[i] = fmt.Sprintf("%v", val)
+		keys[i] = EscapeKey(FormatKey(v))
+	}
+	return endpoint + "=" + strings.Join(keys, ",")
+}
+
+// WithController returns a copy of the builder that targets another controller.
+func (b *Builder) WithController(controller string) *Builder {
+	return NewBuilder(b.protocol, controller)
+}
+
+// BaseURL returns the scheme and controller address, such as "https://192.168.1.100".
+func (b *Builder) BaseURL() string {
+	return b.buildBaseURL()
+}
+
+// MemberName returns the module-qualified JSON member name (RFC 7951) under which the controller
+// returns the resource at endpointPath, e.g. "Cisco-IOS-XE-wireless-client-oper:common-oper-data".
+// List keys and query parameters are ignored. It returns an empty string for paths without a module.
+func MemberName(endpointPath string) string {
+	endpointPath, _, _ = strings.Cut(endpointPath, "?")
+	endpointPath = strings.TrimPrefix(endpointPath, routes.RESTCONFDataPath)
+
+	module, node := "", ""
+	for segment := range strings.SplitSeq(strings.Trim(endpointPath, URLPathSeparator), URLPathSeparator) {
+		name, _, _ := strings.Cut(segment, "=")
+		if prefix, local, ok := strings.Cut(name, ":"); ok {
+			module, name = prefix, local
 		}
+		node = name
+	}
+	if module == "" || node == "" {
+		return ""
 	}
-	return fmt.Sprintf("%s=%s", endpoint, strings.Join(strValues, ","))
+	return module + ":" + node
 }
 
 // buildBaseURL constructs the base URL for the controller.
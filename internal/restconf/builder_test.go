@@ -1,6 +1,8 @@
 package restconf
 
 import (
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
@@ -17,6 +19,7 @@ func TestRESTCONFBuilderUnit_NewBuilder_Success(t *testing.T) {
 
 	testutil.AssertStringEquals(t, builder.protocol, protocol, "protocol")
 	testutil.AssertStringEquals(t, builder.controller, controller, "controller")
+	testutil.AssertStringEquals(t, builder.Controller(), controller, "Controller()")
 }
 
 func TestRESTCONFBuilderUnit_buildBaseURL_Success(t *testing.T) {
@@ -199,7 +202,13 @@ func TestRESTCONFBuilderUnit_BuildQueryURL_Success(t *testing.T) {
 			name:       "MAC query",
 			endpoint:   "wtp-mac",
 			identifier: "aa:bb:cc:dd:ee:ff",
-			expected:   "wtp-mac=aa:bb:cc:dd:ee:ff",
+			expected:   "wtp-mac=aa%3Abb%3Acc%3Add%3Aee%3Aff",
+		},
+		{
+			name:       "Reserved characters",
+			endpoint:   "tag-name",
+			identifier: "site/floor 1,east",
+			expected:   "tag-name=site%2Ffloor%201%2Ceast",
 		},
 		{
 			name:       "Empty identifier",
@@ -233,11 +242,17 @@ func TestRESTCONFBuilderUnit_BuildQueryCompositeURL_Success(t *testing.T) {
 			values:   []interface{}{"mac", "slot"},
 			expected: "composite-key=mac,slot",
 		},
+		{
+			name:     "Comma inside a key",
+			endpoint: "composite-key",
+			values:   []interface{}{"a,b", "c"},
+			expected: "composite-key=a%2Cb,c",
+		},
 		{
 			name:     "Mixed types",
 			endpoint: "query",
 			values:   []interface{}{"aa:bb:cc:dd:ee:ff", 0, true},
-			expected: "query=aa:bb:cc:dd:ee:ff,0,true",
+			expected: "query=aa%3Abb%3Acc%3Add%3Aee%3Aff,0,true",
 		},
 		{
 			name:     "Single value",
@@ -273,7 +288,7 @@ func TestRESTCONFBuilderUnit_BuildQueryCompositeURL_Success(t *testing.T) {
 			name:     "Custom type fallback",
 			endpoint: "custom-test",
 			values:   []interface{}{struct{ Name string }{"test"}},
-			expected: "custom-test={test}",
+			expected: "custom-test=%7Btest%7D",
 		},
 	}
 
@@ -284,3 +299,73 @@ func TestRESTCONFBuilderUnit_BuildQueryCompositeURL_Success(t *testing.T) {
 		})
 	}
 }
+
+// TestRESTCONFBuilderUnit_BuildQueryURL_RoundTrip tests that names with reserved characters
+// survive URL parsing and decode back to the original key values.
+func TestRESTCONFBuilderUnit_BuildQueryURL_RoundTrip(t *testing.T) {
+	builder := NewBuilder("https", "192.168.1.1")
+	names := []string{
+		"default-policy-tag", "AP 1F/East", "tag,with,commas", "aa:bb:cc:dd:ee:ff",
+		"profile?#[]@", "50%off", "!$&'()*+;=", "café",
+	}
+
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			single := builder.BuildDataURL(builder.BuildQueryURL(routes.SiteTagConfigQueryPath, name))
+			parsed, err := url.Parse(single)
+			testutil.AssertNoError(t, err, "url.Parse()")
+			testutil.AssertStringEquals(t, parsed.RawQuery, "", "no query string")
+			testutil.AssertStringEquals(t, parsed.Fragment, "", "no fragment")
+			escaped := parsed.EscapedPath()
+			_, key, _ := strings.Cut(escaped[strings.LastIndex(escaped, "/"):], "=")
+			decoded, err := url.PathUnescape(key)
+			testutil.AssertNoError(t, err, "url.PathUnescape()")
+			testutil.AssertStringEquals(t, decoded, name, "decoded key")
+
+			composite := builder.BuildQueryCompositeURL(routes.APRadioOperDataPath, name, 1)
+			_, keys, _ := strings.Cut(composite[strings.LastIndex(composite, "/"):], "=")
+			parts := strings.Split(keys, ",")
+			testutil.AssertIntEquals(t, len(parts), 2, "composite key count")
+			decoded, err = url.PathUnescape(parts[0])
+			testutil.AssertNoError(t, err, "url.PathUnescape()")
+			testutil.AssertStringEquals(t, decoded, name, "decoded composite key")
+		})
+	}
+}
+
+func TestRESTCONFBuilderUnit_MemberName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			"list below container",
+			routes.ClientCommonOperDataPath,
+			"Cisco-IOS-XE-wireless-client-oper:common-oper-data",
+		},
+		{
+			"list with key",
+			routes.ClientCommonOperDataPath + "=02:40:f1:f7:f7:87",
+			"Cisco-IOS-XE-wireless-client-oper:common-oper-data",
+		},
+		{
+			"top-level container with query",
+			routes.APOperPath + "?depth=1",
+			"Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data",
+		},
+		{
+			"relative path",
+			"Cisco-IOS-XE-wireless-rogue-oper:rogue-oper-data/rogue-data",
+			"Cisco-IOS-XE-wireless-rogue-oper:rogue-data",
+		},
+		{"no module", "/restconf/data/plain", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertStringEquals(t, MemberName(tt.path), tt.expected, "MemberName()")
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package restconf
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+)
+
+// identifierPattern matches a YANG identifier (RFC 7950 section 6.2).
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// Path is a RESTCONF data resource path built from a YANG module, data nodes and list keys.
+// Invalid identifiers are reported by Err, so paths can be built fluently and checked once.
+type Path struct {
+	segments []string // Encoded path segments below the datastore root
+	err      error    // First construction error
+}
+
+// NewPath starts a path at the top-level node of module, e.g.
+// NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data").
+func NewPath(module, node string) Path {
+	var p Path
+	if !identifierPattern.MatchString(module) {
+		p.err = fmt.Errorf("path validation failed: invalid module name %q", module)
+		return p
+	}
+	if !identifierPattern.MatchString(node) {
+		p.err = fmt.Errorf("path validation failed: invalid node name %q", node)
+		return p
+	}
+	p.segments = []string{module + ":" + node}
+	return p
+}
+
+// Node appends a container, leaf or whole list. Nodes of another module, such as augmentations,
+// are given as "module:node".
+func (p Path) Node(name string) Path {
+	if p.err != nil {
+		return p
+	}
+	if err := validateNodeName(name); err != nil {
+		return Path{err: err}
+	}
+	return p.with(name)
+}
+
+// Entry appends a list entry selected by its key values in YANG key order. Key values are
+// formatted in their canonical form and percent-encoded as required by RFC 8040 section 3.5.3.
+func (p Path) Entry(list string, keys ...any) Path {
+	if p.err != nil {
+		return p
+	}
+	if err := validateNodeName(list); err != nil {
+		return Path{err: err}
+	}
+	if len(keys) == 0 {
+		return Path{err: fmt.Errorf("path validation failed: list %q requires at least one key", list)}
+	}
+	escaped := make([]string, len(keys))
+	for i, key := range keys {
+		escaped[i] = EscapeKey(FormatKey(key))
+	}
+	return p.with(list + "=" + strings.Join(escaped, ","))
+}
+
+// Err returns the first error encountered while building the path.
+func (p Path) Err() error {
+	if p.err == nil && len(p.segments) == 0 {
+		return errors.New("path validation failed: path is empty")
+	}
+	return p.err
+}
+
+// String returns the data resource path including the RESTCONF data root,
+// e.g. "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries".
+func (p Path) String() string {
+	if p.Err() != nil {
+		return ""
+	}
+	return routes.RESTCONFDataPath + URLPathSeparator + strings.Join(p.segments, URLPathSeparator)
+}
+
+// with returns a copy of p with segment appended.
+func (p Path) with(segment string) Path {
+	segments := make([]string, len(p.segments), len(p.segments)+1)
+	copy(segments, p.segments)
+	return Path{segments: append(segments, segment)}
+}
+
+// Operation is a RESTCONF operation (RPC) resource of a YANG module.
+type Operation struct {
+	module string // YANG module defining the RPC
+	name   string // RPC name
+	err    error  // Construction error
+}
+
+// NewOperation returns the operation resource of rpc in module, e.g.
+// NewOperation("Cisco-IOS-XE-wireless-access-point-cmd-rpc", "set-ap-admin-state").
+func NewOperation(module, rpc string) Operation {
+	switch {
+	case !identifierPattern.MatchString(module):
+		return Operation{err: fmt.Errorf("operation validation failed: invalid module name %q", module)}
+	case !identifierPattern.MatchString(rpc):
+		return Operation{err: fmt.Errorf("operation validation failed: invalid RPC name %q", rpc)}
+	}
+	return Operation{module: module, name: rpc}
+}
+
+// Err returns the error encountered while building the operation.
+func (o Operation) Err() error {
+	if o.err == nil && o.name == "" {
+		return errors.New("operation validation failed: operation is empty")
+	}
+	return o.err
+}
+
+// String returns the operation path including the RESTCONF operations root.
+func (o Operation) String() string {
+	if o.Err() != nil {
+		return ""
+	}
+	return routes.RESTCONFOperationsPath + URLPathSeparator + o.module + ":" + o.name
+}
+
+// InputMember returns the JSON member name wrapping the RPC input, e.g. "module:input".
+func (o Operation) InputMember() string {
+	return o.module + ":input"
+}
+
+// OutputMember returns the JSON member name wrapping the RPC output, e.g. "module:output".
+func (o Operation) OutputMember() string {
+	return o.module + ":output"
+}
+
+// FormatKey returns the canonical string form of a list key value.
+func FormatKey(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// EscapeKey percent-encodes a list key value for use in a RESTCONF resource path
+// (RFC 8040 section 3.5.3). Every character outside the RFC 3986 unreserved set is encoded,
+// which covers the reserved characters and the "," separating multiple keys.
+func EscapeKey(value string) string {
+	const upperhex = "0123456789ABCDEF"
+	var b strings.Builder
+	b.Grow(len(value))
+	for i := range len(value) {
+		c := value[i]
+		if isUnreserved(c) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(upperhex[c>>4])
+		b.WriteByte(upperhex[c&0x0F])
+	}
+	return b.String()
+}
+
+// isUnreserved reports whether c is an RFC 3986 unreserved character.
+func isUnreserved(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// validateNodeName checks a node name, optionally qualified with its module.
+func validateNodeName(name string) error {
+	module, node, qualified := strings.Cut(name, ":")
+	if qualified && !identifierPattern.MatchString(module) {
+		return fmt.Errorf("path validation failed: invalid module name %q", module)
+	}
+	if !qualified {
+		node = module
+	}
+	if !identifierPattern.MatchString(node) {
+		return fmt.Errorf("path validation failed: invalid node name %q", name)
+	}
+	return nil
+}
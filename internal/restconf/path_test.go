@@ -0,0 +1,109 @@
+package restconf
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+func TestRESTCONFPathUnit_String_Success(t *testing.T) {
+	wlanCfg := NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data")
+
+	testCases := []struct {
+		name     string
+		path     Path
+		expected string
+	}{
+		{
+			"Top-level node", wlanCfg,
+			"/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data",
+		},
+		{
+			"List entry", wlanCfg.Node("wlan-cfg-entries").Entry("wlan-cfg-entry", "corp"),
+			"/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries/wlan-cfg-entry=corp",
+		},
+		{
+			"MAC key", NewPath("Cisco-IOS-XE-wireless-access-point-oper", "access-point-oper-data").
+				Entry("radio-oper-data", "aa:bb:cc:dd:ee:ff", 1),
+			"/restconf/data/Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data/" +
+				"radio-oper-data=aa%3Abb%3Acc%3Add%3Aee%3Aff,1",
+		},
+		{
+			"Reserved characters", wlanCfg.Entry("policy-list-entry", "a,b/c d?e#f%g"),
+			"/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/policy-list-entry=a%2Cb%2Fc%20d%3Fe%23f%25g",
+		},
+		{
+			"Augmented node", wlanCfg.Node("Cisco-IOS-XE-wireless-wlan-ext:ext-data").Node("leaf"),
+			"/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/Cisco-IOS-XE-wireless-wlan-ext:ext-data/leaf",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertNoError(t, tt.path.Err(), "Err()")
+			testutil.AssertStringEquals(t, tt.path.String(), tt.expected, "String()")
+		})
+	}
+}
+
+func TestRESTCONFPathUnit_Branching_Success(t *testing.T) {
+	base := NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data").Node("wlan-cfg-entries")
+	first := base.Entry("wlan-cfg-entry", "first")
+	second := base.Entry("wlan-cfg-entry", "second")
+
+	testutil.AssertStringContains(t, first.String(), "wlan-cfg-entry=first", "first branch")
+	testutil.AssertStringContains(t, second.String(), "wlan-cfg-entry=second", "second branch")
+	testutil.AssertStringEquals(t, base.String(),
+		"/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries", "base path")
+}
+
+func TestRESTCONFPathUnit_Err_Failure(t *testing.T) {
+	valid := NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan-cfg-data")
+
+	testCases := []struct {
+		name string
+		path Path
+	}{
+		{"Zero path", Path{}},
+		{"Invalid module", NewPath("bad module", "wlan-cfg-data")},
+		{"Invalid node", NewPath("Cisco-IOS-XE-wireless-wlan-cfg", "wlan/cfg")},
+		{"Invalid child", valid.Node("1st")},
+		{"Invalid child module", valid.Node(":node")},
+		{"Missing keys", valid.Entry("wlan-cfg-entry")},
+		{"Error is sticky", valid.Node("bad node").Node("wlan-cfg-entries")},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertError(t, tt.path.Err(), "Err()")
+			testutil.AssertStringEquals(t, tt.path.String(), "", "String()")
+		})
+	}
+}
+
+func TestRESTCONFOperationUnit_Success(t *testing.T) {
+	op := NewOperation("Cisco-IOS-XE-wireless-access-point-cmd-rpc", "set-ap-admin-state")
+	testutil.AssertNoError(t, op.Err(), "Err()")
+	testutil.AssertStringEquals(t, op.String(),
+		"/restconf/operations/Cisco-IOS-XE-wireless-access-point-cmd-rpc:set-ap-admin-state", "String()")
+	testutil.AssertStringEquals(t, op.InputMember(), "Cisco-IOS-XE-wireless-access-point-cmd-rpc:input", "InputMember()")
+	testutil.AssertStringEquals(t, op.OutputMember(), "Cisco-IOS-XE-wireless-access-point-cmd-rpc:output",
+		"OutputMember()")
+
+	testutil.AssertError(t, NewOperation("", "reload").Err(), "invalid module")
+	testutil.AssertError(t, NewOperation("Cisco-IOS-XE-rpc", "re load").Err(), "invalid RPC")
+	testutil.AssertError(t, Operation{}.Err(), "zero operation")
+}
+
+func TestRESTCONFPathUnit_EscapeKey_RoundTrip(t *testing.T) {
+	for _, key := range []string{"corp", "aa:bb:cc:dd:ee:ff", "a,b", "x/y", "sp ace", "100%", "ünïcode", "~._-"} {
+		escaped := EscapeKey(key)
+		unescaped, err := url.PathUnescape(escaped)
+		testutil.AssertNoError(t, err, key)
+		testutil.AssertStringEquals(t, unescaped, key, "round trip of "+key)
+	}
+	testutil.AssertStringEquals(t, EscapeKey("~._-AZaz09"), "~._-AZaz09", "unreserved characters")
+	testutil.AssertStringEquals(t, FormatKey(true), "true", "bool key")
+	testutil.AssertStringEquals(t, FormatKey(2.5), "2.5", "float key")
+}
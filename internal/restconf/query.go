@@ -0,0 +1,127 @@
+package restconf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RFC 8040 "content" query parameter values.
+const (
+	// ContentConfig returns configuration data only.
+	ContentConfig = "config"
+	// ContentNonConfig returns operational (non-configuration) data only.
+	ContentNonConfig = "nonconfig"
+	// ContentAll returns both configuration and operational data.
+	ContentAll = "all"
+)
+
+// RFC 8040 "with-defaults" query parameter values (RFC 6243 modes).
+const (
+	// WithDefaultsReportAll reports all data nodes including defaults.
+	WithDefaultsReportAll = "report-all"
+	// WithDefaultsTrim omits data nodes set to their schema default.
+	WithDefaultsTrim = "trim"
+	// WithDefaultsExplicit reports data nodes explicitly set by a client.
+	WithDefaultsExplicit = "explicit"
+	// WithDefaultsReportAllTagged reports all data nodes and tags default values.
+	WithDefaultsReportAllTagged = "report-all-tagged"
+)
+
+// MaxDepth is the largest value accepted by the "depth" query parameter.
+const MaxDepth = 65535
+
+// QueryParams holds RFC 8040 query parameters for retrieval requests. Zero values are omitted.
+type QueryParams struct {
+	Fields       []string // Data node selectors joined with ";" in the "fields" parameter
+	Depth        int      // Subtree depth limit in range [1, MaxDepth], zero means unbounded
+	Content      string   // One of ContentConfig, ContentNonConfig or ContentAll
+	WithDefaults string   // One of the WithDefaults* modes
+}
+
+// IsZero reports whether no query parameter is set.
+func (q QueryParams) IsZero() bool {
+	return len(q.Fields) == 0 && q.Depth == 0 && q.Content == "" && q.WithDefaults == ""
+}
+
+// Validate checks the query parameter values against RFC 8040.
+func (q QueryParams) Validate() error {
+	for _, field := range q.Fields {
+		if strings.TrimSpace(field) == "" {
+			return errors.New("query parameter validation failed: fields entries cannot be empty")
+		}
+	}
+	if q.Depth < 0 || q.Depth > MaxDepth {
+		return fmt.Errorf("query parameter validation failed: depth must be between 1 and %d, got %d",
+			MaxDepth, q.Depth)
+	}
+	switch q.Content {
+	case "", ContentConfig, ContentNonConfig, ContentAll:
+	default:
+		return fmt.Errorf("query parameter validation failed: unsupported content %q", q.Content)
+	}
+	switch q.WithDefaults {
+	case "", WithDefaultsReportAll, WithDefaultsTrim, WithDefaultsExplicit, WithDefaultsReportAllTagged:
+	default:
+		return fmt.Errorf("query parameter validation failed: unsupported with-defaults %q", q.WithDefaults)
+	}
+	return nil
+}
+
+// Encode returns the query string without the leading "?", such as "depth=2&fields=name;ip-addr".
+// Parameters are emitted in a stable order.
+func (q QueryParams) Encode() string {
+	var params []string
+	if q.Content != "" {
+		params = append(params, "content="+q.Content)
+	}
+	if q.Depth > 0 {
+		params = append(params, "depth="+strconv.Itoa(q.Depth))
+	}
+	if len(q.Fields) > 0 {
+		params = append(params, "fields="+escapeQueryValue(strings.Join(q.Fields, ";")))
+	}
+	if q.WithDefaults != "" {
+		params = append(params, "with-defaults="+q.WithDefaults)
+	}
+	return strings.Join(params, "&")
+}
+
+// BuildPathWithQuery appends the encoded query parameters to an endpoint path.
+func (b *Builder) BuildPathWithQuery(endpointPath string, query QueryParams) string {
+	encoded := query.Encode()
+	if encoded == "" {
+		return endpointPath
+	}
+	separator := "?"
+	if strings.Contains(endpointPath, "?") {
+		separator = "&"
+	}
+	return endpointPath + separator + encoded
+}
+
+// escapeQueryValue percent-encodes characters that cannot appear literally in a query value.
+// The "fields" syntax characters "/", ";", ":", "(", ")" and "," are kept as is.
+func escapeQueryValue(value string) string {
+	var sb strings.Builder
+	for i := range len(value) {
+		ch := value[i]
+		if isQueryValueChar(ch) {
+			sb.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(&sb, "%%%02X", ch)
+	}
+	return sb.String()
+}
+
+// isQueryValueChar reports whether ch is an RFC 3986 unreserved character or a
+// sub-delimiter that is safe inside a query value.
+func isQueryValueChar(ch byte) bool {
+	switch {
+	case 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z', '0' <= ch && ch <= '9':
+		return true
+	}
+	return strings.IndexByte("-._~/:;(),@!$'*", ch) >= 0
+}
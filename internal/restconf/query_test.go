@@ -0,0 +1,78 @@
+package restconf
+
+import (
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+func TestRESTCONFQueryUnit_Encode_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    QueryParams
+		expected string
+	}{
+		{"empty", QueryParams{}, ""},
+		{"depth", QueryParams{Depth: 3}, "depth=3"},
+		{"content", QueryParams{Content: ContentConfig}, "content=config"},
+		{"with-defaults", QueryParams{WithDefaults: WithDefaultsReportAll}, "with-defaults=report-all"},
+		{
+			"fields with sub-selectors",
+			QueryParams{Fields: []string{"name", "ap-state(ap-admin-state;ap-operation-state)"}},
+			"fields=name;ap-state(ap-admin-state;ap-operation-state)",
+		},
+		{"fields escaping", QueryParams{Fields: []string{"a b&c"}}, "fields=a%20b%26c"},
+		{
+			"all parameters",
+			QueryParams{Fields: []string{"name"}, Depth: 1, Content: ContentNonConfig, WithDefaults: WithDefaultsTrim},
+			"content=nonconfig&depth=1&fields=name&with-defaults=trim",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertStringEquals(t, tt.query.Encode(), tt.expected, "Encode()")
+			testutil.AssertBoolEquals(t, tt.query.IsZero(), tt.expected == "", "IsZero()")
+		})
+	}
+}
+
+func TestRESTCONFQueryUnit_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   QueryParams
+		wantErr bool
+	}{
+		{"empty", QueryParams{}, false},
+		{"valid", QueryParams{Fields: []string{"name"}, Depth: MaxDepth, Content: ContentAll}, false},
+		{"negative depth", QueryParams{Depth: -1}, true},
+		{"depth too large", QueryParams{Depth: MaxDepth + 1}, true},
+		{"blank field", QueryParams{Fields: []string{" "}}, true},
+		{"unknown content", QueryParams{Content: "state"}, true},
+		{"unknown with-defaults", QueryParams{WithDefaults: "none"}, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+			if tt.wantErr {
+				testutil.AssertError(t, err, "Validate()")
+			} else {
+				testutil.AssertNoError(t, err, "Validate()")
+			}
+		})
+	}
+}
+
+func TestRESTCONFQueryUnit_BuildPathWithQuery(t *testing.T) {
+	builder := NewBuilder("https", "192.168.1.100")
+	path := "/restconf/data/Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data"
+
+	testutil.AssertStringEquals(t, builder.BuildPathWithQuery(path, QueryParams{}), path, "no parameters")
+	testutil.AssertStringEquals(t, builder.BuildPathWithQuery(path, QueryParams{Depth: 1}),
+		path+"?depth=1", "with parameters")
+	testutil.AssertStringEquals(t, builder.BuildPathWithQuery(path+"?content=config", QueryParams{Depth: 1}),
+		path+"?content=config&depth=1", "existing query")
+	testutil.AssertStringEquals(t, builder.BuildDataURL(builder.BuildPathWithQuery(path, QueryParams{Depth: 1})),
+		"https://192.168.1.100"+path+"?depth=1", "data URL")
+}
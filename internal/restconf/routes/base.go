@@ -21,3 +21,9 @@ const (
 // 	// CfgDataSuffix is the common suffix for configuration data endpoints
 // 	CfgDataSuffix = YANGModelCfgSuffix + "-data"
 // )
+
+// RESTCONF root discovery path (RFC 8040 §3.1).
+const (
+	// WellKnownHostMetaPath is the root resource discovery document served by every RESTCONF server.
+	WellKnownHostMetaPath = "/.well-known/host-meta"
+)
@@ -12,4 +12,7 @@ const (
 
 	// FlexPolicyEntriesPath defines the path for FlexConnect policy entries.
 	FlexPolicyEntriesPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-flex-cfg:flex-cfg-data/flex-policy-entries"
+
+	// FlexPolicyEntryByNamePath defines the path for a FlexConnect policy entry by name.
+	FlexPolicyEntryByNamePath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-flex-cfg:flex-cfg-data/flex-policy-entries/flex-policy-entry"
 )
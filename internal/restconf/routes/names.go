@@ -0,0 +1,287 @@
+package routes
+
+import "strings"
+
+// names maps each distinct route value to its constant name. When several constants share
+// a value, the plain path constant is preferred over its Query or By* alias.
+var names = map[string]string{
+	WellKnownHostMetaPath:                   "WellKnownHostMetaPath",
+	RESTCONFDataPath:                        "RESTCONFDataPath",
+	APOperPath:                              "APOperPath",
+	APImageActiveLocationPath:               "APImageActiveLocationPath",
+	APImagePrepareLocationPath:              "APImagePrepareLocationPath",
+	APApIoxOperDataPath:                     "APApIoxOperDataPath",
+	APApNameMACMapPath:                      "APApNameMACMapPath",
+	APApNhGlobalDataPath:                    "APApNhGlobalDataPath",
+	APPwrInfoPath:                           "APPwrInfoPath",
+	APRadioNeighborPath:                     "APRadioNeighborPath",
+	APSensorStatusPath:                      "APSensorStatusPath",
+	APCapwapDataPath:                        "APCapwapDataPath",
+	APCapwapPktsPath:                        "APCapwapPktsPath",
+	APCdpCacheDataPath:                      "APCdpCacheDataPath",
+	APCountryOperPath:                       "APCountryOperPath",
+	APDiscDataPath:                          "APDiscDataPath",
+	APEthernetIfStatsPath:                   "APEthernetIfStatsPath",
+	APEthernetMACWtpMACMapPath:              "APEthernetMACWtpMACMapPath",
+	APEwlcMewlcPredownloadRecPath:           "APEwlcMewlcPredownloadRecPath",
+	APEwlcWncdStatsPath:                     "APEwlcWncdStatsPath",
+	APIotFirmwarePath:                       "APIotFirmwarePath",
+	APLldpNeighPath:                         "APLldpNeighPath",
+	APOperDataPath:                          "APOperDataPath",
+	APQosClientDataPath:                     "APQosClientDataPath",
+	APQosGlobalStatsPath:                    "APQosGlobalStatsPath",
+	APRadioOperDataPath:                     "APRadioOperDataPath",
+	APRadioOperStatsPath:                    "APRadioOperStatsPath",
+	APRadioResetStatsPath:                   "APRadioResetStatsPath",
+	APRlanOperPath:                          "APRlanOperPath",
+	APSuppCountryOperPath:                   "APSuppCountryOperPath",
+	APTpCertInfoPath:                        "APTpCertInfoPath",
+	APWtpSlotWlanStatsPath:                  "APWtpSlotWlanStatsPath",
+	AFCCloudOperPath:                        "AFCCloudOperPath",
+	AFCAfcCloudStatsPath:                    "AFCAfcCloudStatsPath",
+	AFCOperPath:                             "AFCOperPath",
+	AFCEwlcAFCApReqPath:                     "AFCEwlcAFCApReqPath",
+	AFCEwlcAFCApRespPath:                    "AFCEwlcAFCApRespPath",
+	APCfgPath:                               "APCfgPath",
+	APTagPath:                               "APTagPath",
+	APTagsPath:                              "APTagsPath",
+	APTagQueryPath:                          "APTagQueryPath",
+	APTagSourcePriorityConfigsPath:          "APTagSourcePriorityConfigsPath",
+	APGlobalOperPath:                        "APGlobalOperPath",
+	APHistoryPath:                           "APHistoryPath",
+	APJoinStatsPath:                         "APJoinStatsPath",
+	APEwlcApStatsPath:                       "APEwlcApStatsPath",
+	APWlanClientStatsPath:                   "APWlanClientStatsPath",
+	APFCfgPath:                              "APFCfgPath",
+	APFAPFPath:                              "APFAPFPath",
+	AWIPSCfgPath:                            "AWIPSCfgPath",
+	AWIPSProfilesPath:                       "AWIPSProfilesPath",
+	AWIPSOperPath:                           "AWIPSOperPath",
+	AWIPSApDownloadStatusPath:               "AWIPSApDownloadStatusPath",
+	AWIPSDwldStatusPath:                     "AWIPSDwldStatusPath",
+	AWIPSDwldStatusWncdPath:                 "AWIPSDwldStatusWncdPath",
+	AWIPSGlobStatsPath:                      "AWIPSGlobStatsPath",
+	AWIPSPerApInfoPath:                      "AWIPSPerApInfoPath",
+	AWIPSPerSignStatsPath:                   "AWIPSPerSignStatsPath",
+	BLELtxOperPath:                          "BLELtxOperPath",
+	BLELtxApPath:                            "BLELtxApPath",
+	BLELtxApAntennaPath:                     "BLELtxApAntennaPath",
+	BLEMgmtOperPath:                         "BLEMgmtOperPath",
+	BLEMgmtApPath:                           "BLEMgmtApPath",
+	BLEMgmtCmxPath:                          "BLEMgmtCmxPath",
+	SpacesOperPath:                          "SpacesOperPath",
+	SpacesConnectionDetailPath:              "SpacesConnectionDetailPath",
+	ClientOperPath:                          "ClientOperPath",
+	ClientCommonOperDataPath:                "ClientCommonOperDataPath",
+	ClientDcInfoPath:                        "ClientDcInfoPath",
+	ClientDot11OperDataPath:                 "ClientDot11OperDataPath",
+	ClientMmIfClientHistoryPath:             "ClientMmIfClientHistoryPath",
+	ClientMmIfClientStatsPath:               "ClientMmIfClientStatsPath",
+	ClientMobilityOperDataPath:              "ClientMobilityOperDataPath",
+	ClientPolicyDataPath:                    "ClientPolicyDataPath",
+	ClientSisfDBMacPath:                     "ClientSisfDBMacPath",
+	ClientTrafficStatsPath:                  "ClientTrafficStatsPath",
+	CTSCfgPath:                              "CTSCfgPath",
+	CTSOperPath:                             "CTSOperPath",
+	CTSFlexModeApSxpConnectionStatusPath:    "CTSFlexModeApSxpConnectionStatusPath",
+	Dot11CfgPath:                            "Dot11CfgPath",
+	Dot11ConfiguredCountriesPath:            "Dot11ConfiguredCountriesPath",
+	Dot11EntriesPath:                        "Dot11EntriesPath",
+	Dot11AcMcsEntriesPath:                   "Dot11AcMcsEntriesPath",
+	Dot15CfgPath:                            "Dot15CfgPath",
+	Dot15GlobalConfigPath:                   "Dot15GlobalConfigPath",
+	FabricCfgPath:                           "FabricCfgPath",
+	FabricPath:                              "FabricPath",
+	FabricControlplaneNamesPath:             "FabricControlplaneNamesPath",
+	FabricProfilesPath:                      "FabricProfilesPath",
+	FlexCfgPath:                             "FlexCfgPath",
+	FlexPolicyEntriesPath:                   "FlexPolicyEntriesPath",
+	FlexPolicyEntryByNamePath:               "FlexPolicyEntryByNamePath",
+	GeneralCfgPath:                          "GeneralCfgPath",
+	GeneralApLocRangingCfgPath:              "GeneralApLocRangingCfgPath",
+	GeneralCacConfigPath:                    "GeneralCacConfigPath",
+	GeneralFeatureUsageCfgPath:              "GeneralFeatureUsageCfgPath",
+	GeneralFipsCfgPath:                      "GeneralFipsCfgPath",
+	GeneralGeolocationCfgPath:               "GeneralGeolocationCfgPath",
+	GeneralLaginfoPath:                      "GeneralLaginfoPath",
+	GeneralMewlcConfigPath:                  "GeneralMewlcConfigPath",
+	GeneralMfpPath:                          "GeneralMfpPath",
+	GeneralMulticastConfigPath:              "GeneralMulticastConfigPath",
+	GeneralSimL3InterfaceCacheDataPath:      "GeneralSimL3InterfaceCacheDataPath",
+	GeneralThresholdWarnCfgPath:             "GeneralThresholdWarnCfgPath",
+	GeneralWlcManagementDataPath:            "GeneralWlcManagementDataPath",
+	GeneralWsaApClientEventPath:             "GeneralWsaApClientEventPath",
+	GeneralOperPath:                         "GeneralOperPath",
+	GeneralMgmtIntfDataPath:                 "GeneralMgmtIntfDataPath",
+	GeolocationOperPath:                     "GeolocationOperPath",
+	GeolocationApGeoLocDataPath:             "GeolocationApGeoLocDataPath",
+	GeolocationApGeoLocStatsPath:            "GeolocationApGeoLocStatsPath",
+	HyperlocationOperPath:                   "HyperlocationOperPath",
+	HyperlocationProfilesPath:               "HyperlocationProfilesPath",
+	LISPOperPath:                            "LISPOperPath",
+	LISPMemoryStatsPath:                     "LISPMemoryStatsPath",
+	LISPAPCapabilitiesPath:                  "LISPAPCapabilitiesPath",
+	LISPCapabilitiesPath:                    "LISPCapabilitiesPath",
+	LocationCfgPath:                         "LocationCfgPath",
+	LocationPath:                            "LocationPath",
+	LocationNMSPConfigPath:                  "LocationNMSPConfigPath",
+	LocationOperatorLocationsPath:           "LocationOperatorLocationsPath",
+	LocationOperPath:                        "LocationOperPath",
+	LocationRSSIMeasurementsPath:            "LocationRSSIMeasurementsPath",
+	McastOperPath:                           "McastOperPath",
+	McastFabricMediastreamPath:              "McastFabricMediastreamPath",
+	McastFlexMediastreamPath:                "McastFlexMediastreamPath",
+	McastGroupsPath:                         "McastGroupsPath",
+	McastMgidInfoPath:                       "McastMgidInfoPath",
+	McastMulticastOperDataPath:              "McastMulticastOperDataPath",
+	McastRrcHistoryClientRecordDataPath:     "McastRrcHistoryClientRecordDataPath",
+	McastRrcSrRadioRecordPath:               "McastRrcSrRadioRecordPath",
+	McastRrcStreamAdmitRecordPath:           "McastRrcStreamAdmitRecordPath",
+	McastRrcStreamDenyRecordPath:            "McastRrcStreamDenyRecordPath",
+	McastRrcStreamRecordPath:                "McastRrcStreamRecordPath",
+	McastStatisticsPath:                     "McastStatisticsPath",
+	McastVlanL2MgidPath:                     "McastVlanL2MgidPath",
+	MDNSOperPath:                            "MDNSOperPath",
+	MDNSGlobalStatsPath:                     "MDNSGlobalStatsPath",
+	MDNSWlanStatsPath:                       "MDNSWlanStatsPath",
+	MeshCfgPath:                             "MeshCfgPath",
+	MeshApCacInfoPath:                       "MeshApCacInfoPath",
+	MeshApPathInfoPath:                      "MeshApPathInfoPath",
+	MeshApTreeDataPath:                      "MeshApTreeDataPath",
+	MeshGlobalStatsPath:                     "MeshGlobalStatsPath",
+	MeshOperPath:                            "MeshOperPath",
+	MeshDataRateStatsPath:                   "MeshDataRateStatsPath",
+	MeshOperationalDataPath:                 "MeshOperationalDataPath",
+	MeshQueueStatsPath:                      "MeshQueueStatsPath",
+	MeshSecurityStatsPath:                   "MeshSecurityStatsPath",
+	MobilityCfgPath:                         "MobilityCfgPath",
+	MobilityConfigPath:                      "MobilityConfigPath",
+	MobilityOperPath:                        "MobilityOperPath",
+	MobilityApCachePath:                     "MobilityApCachePath",
+	MobilityApPeerListPath:                  "MobilityApPeerListPath",
+	MobilityMmGlobalDataPath:                "MobilityMmGlobalDataPath",
+	MobilityMmIfGlobalMsgStatsPath:          "MobilityMmIfGlobalMsgStatsPath",
+	MobilityMmIfGlobalStatsPath:             "MobilityMmIfGlobalStatsPath",
+	MobilityClientDataPath:                  "MobilityClientDataPath",
+	MobilityClientStatsPath:                 "MobilityClientStatsPath",
+	MobilityGlobalDTLSStatsPath:             "MobilityGlobalDTLSStatsPath",
+	MobilityGlobalMsgStatsPath:              "MobilityGlobalMsgStatsPath",
+	MobilityGlobalStatsPath:                 "MobilityGlobalStatsPath",
+	MobilityWlanClientLimitPath:             "MobilityWlanClientLimitPath",
+	NMSPOperPath:                            "NMSPOperPath",
+	NMSPClientRegistrationPath:              "NMSPClientRegistrationPath",
+	NMSPCmxCloudInfoPath:                    "NMSPCmxCloudInfoPath",
+	NMSPCmxConnectionPath:                   "NMSPCmxConnectionPath",
+	RadioCfgPath:                            "RadioCfgPath",
+	RadioProfilesPath:                       "RadioProfilesPath",
+	RFCfgPath:                               "RFCfgPath",
+	AtfPoliciesPath:                         "AtfPoliciesPath",
+	MultiBssidProfilesPath:                  "MultiBssidProfilesPath",
+	RFProfileDefaultEntriesPath:             "RFProfileDefaultEntriesPath",
+	RFProfilesPath:                          "RFProfilesPath",
+	RFProfileByNamePath:                     "RFProfileByNamePath",
+	RFTagsPath:                              "RFTagsPath",
+	RFTagByNamePath:                         "RFTagByNamePath",
+	RFIDCfgPath:                             "RFIDCfgPath",
+	RFIDCfgRFIDConfigPath:                   "RFIDCfgRFIDConfigPath",
+	RFIDGlobalOperPath:                      "RFIDGlobalOperPath",
+	RFIDDataDetailPath:                      "RFIDDataDetailPath",
+	RFIDRadioDataPath:                       "RFIDRadioDataPath",
+	RFIDOperPath:                            "RFIDOperPath",
+	RFIDDataQueryPath:                       "RFIDDataQueryPath",
+	RogueOperPath:                           "RogueOperPath",
+	RogueClientDataPath:                     "RogueClientDataPath",
+	RogueDataPath:                           "RogueDataPath",
+	RogueStatsPath:                          "RogueStatsPath",
+	RRMCfgPath:                              "RRMCfgPath",
+	RRMCfgRRMMgrCfgEntriesPath:              "RRMCfgRRMMgrCfgEntriesPath",
+	RRMCfgRrmsPath:                          "RRMCfgRrmsPath",
+	RRMEmulOperPath:                         "RRMEmulOperPath",
+	RRMEmulApDataPath:                       "RRMEmulApDataPath",
+	RRMEmulOperRRMFraStatsPath:              "RRMEmulOperRRMFraStatsPath",
+	RRMGlobalOperPath:                       "RRMGlobalOperPath",
+	RRMGlobalOperRadioOperData24gPath:       "RRMGlobalOperRadioOperData24gPath",
+	RRMGlobalOperRadioOperData5gPath:        "RRMGlobalOperRadioOperData5gPath",
+	RRMGlobalOperRadioOperData6ghzPath:      "RRMGlobalOperRadioOperData6ghzPath",
+	RRMGlobalOperRadioOperDataDualbandPath:  "RRMGlobalOperRadioOperDataDualbandPath",
+	RRMGlobalOperRRMChannelParamsPath:       "RRMGlobalOperRRMChannelParamsPath",
+	RRMGlobalOperRRMClientDataPath:          "RRMGlobalOperRRMClientDataPath",
+	RRMGlobalOperRRMCoveragePath:            "RRMGlobalOperRRMCoveragePath",
+	RRMGlobalOperRRMFraStatsPath:            "RRMGlobalOperRRMFraStatsPath",
+	RRMGlobalStatsPath:                      "RRMGlobalStatsPath",
+	RRMGlobalOperRRMOneShotCountersPath:     "RRMGlobalOperRRMOneShotCountersPath",
+	RRMGlobalOperSpectrumAqWorstTablePath:   "RRMGlobalOperSpectrumAqWorstTablePath",
+	RRMGlobalOperSpectrumBandConfigDataPath: "RRMGlobalOperSpectrumBandConfigDataPath",
+	RRMOperPath:                             "RRMOperPath",
+	RRMOperApAutoRFDot11DataPath:            "RRMOperApAutoRFDot11DataPath",
+	RRMOperApDot11RadarDataPath:             "RRMOperApDot11RadarDataPath",
+	RRMOperApDot11SpectrumDataPath:          "RRMOperApDot11SpectrumDataPath",
+	RRMOperMainDataPath:                     "RRMOperMainDataPath",
+	RRMOperRadioSlotPath:                    "RRMOperRadioSlotPath",
+	RRMOperRegDomainOperPath:                "RRMOperRegDomainOperPath",
+	RRM24GhzConfigPath:                      "RRM24GhzConfigPath",
+	RRM5GhzConfigPath:                       "RRM5GhzConfigPath",
+	RRMLoadStatsPath:                        "RRMLoadStatsPath",
+	RRMOperRRMMeasurementPath:               "RRMOperRRMMeasurementPath",
+	RRMNeighborStatsPath:                    "RRMNeighborStatsPath",
+	RRMNoiseStatsPath:                       "RRMNoiseStatsPath",
+	RRMRadioStatsPath:                       "RRMRadioStatsPath",
+	RRMOperSpectrumAqTablePath:              "RRMOperSpectrumAqTablePath",
+	RRMOperSpectrumDeviceTablePath:          "RRMOperSpectrumDeviceTablePath",
+	SiteCfgPath:                             "SiteCfgPath",
+	APProfilesPath:                          "APProfilesPath",
+	APCfgProfileQueryPath:                   "APCfgProfileQueryPath",
+	SiteTagConfigsPath:                      "SiteTagConfigsPath",
+	SiteTagConfigQueryPath:                  "SiteTagConfigQueryPath",
+	SiteTagsPath:                            "SiteTagsPath",
+	SiteTagByNamePath:                       "SiteTagByNamePath",
+	SpacesCfgPath:                           "SpacesCfgPath",
+	SpacesProfilesPath:                      "SpacesProfilesPath",
+	URWBCfgPath:                             "URWBCfgPath",
+	URWBProfilesPath:                        "URWBProfilesPath",
+	URWBNetOperPath:                         "URWBNetOperPath",
+	URWBNetNodeGroupPath:                    "URWBNetNodeGroupPath",
+	URWBNetStatsPath:                        "URWBNetStatsPath",
+	WATCfgPath:                              "WATCfgPath",
+	WATEnablePath:                           "WATEnablePath",
+	WATProfilesPath:                         "WATProfilesPath",
+	WATTestProfilePath:                      "WATTestProfilePath",
+	WATThousandeyesPath:                     "WATThousandeyesPath",
+	WLANCfgPath:                             "WLANCfgPath",
+	WLANDot11beProfilesPath:                 "WLANDot11beProfilesPath",
+	WLANPolicyListEntriesPath:               "WLANPolicyListEntriesPath",
+	WLANPolicyListEntryQueryPath:            "WLANPolicyListEntryQueryPath",
+	WLANWlanCfgEntryQueryPath:               "WLANWlanCfgEntryQueryPath",
+	WLANWlanPolicyQueryPath:                 "WLANWlanPolicyQueryPath",
+	WLANWirelessAaaPolicyConfigsPath:        "WLANWirelessAaaPolicyConfigsPath",
+	WLANWlanCfgEntriesPath:                  "WLANWlanCfgEntriesPath",
+	WLANWlanPoliciesPath:                    "WLANWlanPoliciesPath",
+	WLANGlobalOperPath:                      "WLANGlobalOperPath",
+	WLANWlanInfoPath:                        "WLANWlanInfoPath",
+	RESTCONFOperationsPath:                  "RESTCONFOperationsPath",
+	ControllerReloadRPC:                     "ControllerReloadRPC",
+	APSetApAdminStateRPC:                    "APSetApAdminStateRPC",
+	APSetApSlotAdminStateRPC:                "APSetApSlotAdminStateRPC",
+	APApResetRPC:                            "APApResetRPC",
+	WATOperPath:                             "WATOperPath",
+}
+
+// Name returns the name of the route constant matching path, such as "APCapwapDataPath".
+//
+// List keys ("=key"), query strings and sub-resources below a known route are ignored,
+// so the longest route that prefixes path is chosen. It returns an empty string when
+// path is not below any known route.
+func Name(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	for candidate := path; candidate != ""; {
+		if name, ok := names[candidate]; ok {
+			return name
+		}
+		i := strings.LastIndexAny(candidate, "/=")
+		if i < 0 {
+			break
+		}
+		candidate = candidate[:i]
+	}
+	return ""
+}
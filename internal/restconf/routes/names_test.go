@@ -0,0 +1,83 @@
+package routes
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+func TestRoutesUnit_Name_Success(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{"exact route", APCapwapDataPath, "APCapwapDataPath"},
+		{"list key", APCapwapDataPath + "=00:11:22:33:44:55", "APCapwapDataPath"},
+		{"list key and sub-resource", APCapwapDataPath + "=00:11:22:33:44:55/name", "APCapwapDataPath"},
+		{"composite key", APTagQueryPath + "=aa:bb:cc:dd:ee:ff,default", "APTagQueryPath"},
+		{"query string", APOperPath + "?depth=1", "APOperPath"},
+		{"shared value prefers plain path", APHistoryQueryPath, "APHistoryPath"},
+		{"RPC", APApResetRPC, "APApResetRPC"},
+		{"unknown module falls back to base", RESTCONFDataPath + "/unknown:data", "RESTCONFDataPath"},
+		{"unrelated path", "/unknown", ""},
+		{"empty path", "", ""},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertStringEquals(t, Name(tt.path), tt.expected, "Name()")
+		})
+	}
+}
+
+// TestRoutesUnit_Name_CoversAllConstants keeps the names table in sync with the route constants.
+func TestRoutesUnit_Name_CoversAllConstants(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("failed to parse routes package: %v", err)
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("routes", fset, files, nil)
+	if err != nil {
+		t.Fatalf("failed to type-check routes package: %v", err)
+	}
+
+	for _, ident := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(ident).(*types.Const)
+		if !ok || !c.Exported() {
+			continue
+		}
+		path := strings.Trim(c.Val().ExactString(), `"`)
+		name, ok := names[path]
+		if !ok {
+			t.Errorf("route constant %s is missing from the names table", ident)
+			continue
+		}
+		if name != ident && pkg.Scope().Lookup(name) == nil {
+			t.Errorf("names table maps %s to unknown constant %s", ident, name)
+		}
+	}
+	for path, name := range names {
+		if pkg.Scope().Lookup(name) == nil {
+			t.Errorf("names table entry %q refers to unknown constant %s", path, name)
+		}
+	}
+}
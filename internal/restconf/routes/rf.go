@@ -13,6 +13,9 @@ const (
 	// RFProfilesPath provides the path for retrieving RF profiles.
 	RFProfilesPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-profiles"
 
+	// RFProfileByNamePath provides the path for retrieving RF profile by name.
+	RFProfileByNamePath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-profiles/rf-profile"
+
 	// RFTagsPath provides the path for retrieving RF tags.
 	RFTagsPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags"
 
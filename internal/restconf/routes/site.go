@@ -27,4 +27,7 @@ const (
 const (
 	// SiteTagConfigQueryPath provides the path for querying site tag config by tag name.
 	SiteTagConfigQueryPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-site-cfg:site-cfg-data/site-tag-configs/site-tag-config"
+
+	// APCfgProfileQueryPath provides the path for querying AP config profile by profile name.
+	APCfgProfileQueryPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-site-cfg:site-cfg-data/ap-cfg-profiles/ap-cfg-profile"
 )
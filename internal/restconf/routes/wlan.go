@@ -39,4 +39,10 @@ const (
 const (
 	// WLANPolicyListEntryQueryPath provides the path for querying policy list entry by tag name.
 	WLANPolicyListEntryQueryPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/policy-list-entries/policy-list-entry"
+
+	// WLANWlanPolicyQueryPath provides the path for querying WLAN policy profile by name.
+	WLANWlanPolicyQueryPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-policies/wlan-policy"
+
+	// WLANWlanCfgEntryQueryPath provides the path for querying WLAN configuration entry by profile name.
+	WLANWlanCfgEntryQueryPath = RESTCONFDataPath + "/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries/wlan-cfg-entry"
 )
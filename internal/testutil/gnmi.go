@@ -0,0 +1,77 @@
+package testutil
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// GNMIHandler serves one Subscribe stream. conn counts the streams opened so far, starting at 1.
+// Returning nil keeps the stream open until the client cancels it; returning an error ends it.
+type GNMIHandler func(conn int, req *gpb.SubscribeRequest, send func(*gpb.SubscribeResponse) error) error
+
+// GNMIServer is a local plaintext gRPC server implementing gNMI Subscribe.
+// It stands in for a controller in tests of telemetry subscriptions.
+type GNMIServer struct {
+	gpb.UnimplementedGNMIServer
+
+	Addr string // Listening address "127.0.0.1:port"
+
+	handler  GNMIHandler
+	mu       sync.Mutex
+	requests []*gpb.SubscribeRequest
+	metadata []metadata.MD
+}
+
+// NewGNMIServer starts a stand-in server serving Subscribe streams with handler.
+// It is stopped when the test ends.
+func NewGNMIServer(t *testing.T, handler GNMIHandler) *GNMIServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	AssertNoError(t, err, "listen")
+	server := &GNMIServer{Addr: listener.Addr().String(), handler: handler}
+	grpcServer := grpc.NewServer()
+	gpb.RegisterGNMIServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+	return server
+}
+
+// Subscribe records the subscription request and metadata and runs the handler.
+func (s *GNMIServer) Subscribe(stream gpb.GNMI_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.metadata = append(s.metadata, md)
+	conn := len(s.requests)
+	s.mu.Unlock()
+
+	if err := s.handler(conn, req, stream.Send); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+// Requests returns the subscription requests received so far, one per stream.
+func (s *GNMIServer) Requests() []*gpb.SubscribeRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*gpb.SubscribeRequest(nil), s.requests...)
+}
+
+// Metadata returns the request metadata of each stream, such as the username and password.
+func (s *GNMIServer) Metadata() []metadata.MD {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]metadata.MD(nil), s.metadata...)
+}
@@ -0,0 +1,211 @@
+package testutil
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// NETCONF stand-in server constants.
+const (
+	// NETCONFUsername is the user name accepted by NETCONFServer.
+	NETCONFUsername = "admin"
+	// NETCONFPassword is the password accepted by NETCONFServer.
+	NETCONFPassword = "password"
+)
+
+// messageIDPattern extracts the message-id attribute of an <rpc>.
+var messageIDPattern = regexp.MustCompile(`message-id="([^"]*)"`)
+
+// NETCONFHandler returns the content of the <rpc-reply> for a received <rpc> document,
+// for example "<ok/>", "<data>...</data>" or "<rpc-error>...</rpc-error>".
+type NETCONFHandler func(rpc string) string
+
+// NETCONFServer is a local SSH server speaking NETCONF 1.1 on the "netconf" subsystem.
+// It stands in for a controller in tests of the NETCONF transport.
+type NETCONFServer struct {
+	Port int // Listening port on 127.0.0.1
+
+	listener net.Listener
+	handler  NETCONFHandler
+	mu       sync.Mutex
+	requests []string
+}
+
+// NewNETCONFServer starts a stand-in server answering RPCs with handler. It accepts
+// NETCONFUsername and NETCONFPassword and is stopped when the test ends.
+func NewNETCONFServer(t *testing.T, handler NETCONFHandler) *NETCONFServer {
+	t.Helper()
+
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	AssertNoError(t, err, "host key generation")
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	AssertNoError(t, err, "host key signer")
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == NETCONFUsername && string(password) == NETCONFPassword {
+				return nil, nil
+			}
+			return nil, errors.New("invalid credentials")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	AssertNoError(t, err, "listen")
+	server := &NETCONFServer{Port: listener.Addr().(*net.TCPAddr).Port, listener: listener, handler: handler}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serveConn(conn, config)
+		}
+	}()
+	return server
+}
+
+// Requests returns the <rpc> documents received so far.
+func (s *NETCONFServer) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// LastRequest returns the last <rpc> document received, or "".
+func (s *NETCONFServer) LastRequest() string {
+	requests := s.Requests()
+	if len(requests) == 0 {
+		return ""
+	}
+	return requests[len(requests)-1]
+}
+
+// serveConn handles the SSH connection of a client.
+func (s *NETCONFServer) serveConn(conn net.Conn, config *ssh.ServerConfig) {
+	defer conn.Close()
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "only session channels are supported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				ok := req.Type == "subsystem" && strings.HasSuffix(string(req.Payload), "netconf")
+				_ = req.Reply(ok, nil)
+				if ok {
+					go s.serveNETCONF(channel)
+				}
+			}
+		}()
+	}
+}
+
+// serveNETCONF runs the NETCONF protocol on channel.
+func (s *NETCONFServer) serveNETCONF(channel ssh.Channel) {
+	defer channel.Close()
+	r := bufio.NewReader(channel)
+
+	hello := `<hello xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><capabilities>` +
+		`<capability>urn:ietf:params:netconf:base:1.0</capability>` +
+		`<capability>urn:ietf:params:netconf:base:1.1</capability>` +
+		`</capabilities><session-id>1</session-id></hello>]]>]]>`
+	if _, err := io.WriteString(channel, hello); err != nil {
+		return
+	}
+	if _, err := readUntil(r, "]]>]]>"); err != nil {
+		return
+	}
+
+	for {
+		rpc, err := readChunked(r)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.requests = append(s.requests, rpc)
+		s.mu.Unlock()
+
+		var reply string
+		if strings.Contains(rpc, "<close-session") {
+			reply = "<ok/>"
+		} else {
+			reply = s.handler(rpc)
+		}
+		messageID := ""
+		if m := messageIDPattern.FindStringSubmatch(rpc); m != nil {
+			messageID = m[1]
+		}
+		msg := `<rpc-reply xmlns="urn:ietf:params:xml:ns:netconf:base:1.0" message-id="` + messageID + `">` +
+			reply + `</rpc-reply>`
+		if _, err := fmt.Fprintf(channel, "\n#%d\n%s\n##\n", len(msg), msg); err != nil {
+			return
+		}
+		if strings.Contains(rpc, "<close-session") {
+			return
+		}
+	}
+}
+
+// readUntil reads up to and excluding marker.
+func readUntil(r *bufio.Reader, marker string) (string, error) {
+	var sb strings.Builder
+	for !strings.HasSuffix(sb.String(), marker) {
+		c, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(c)
+	}
+	return strings.TrimSuffix(sb.String(), marker), nil
+}
+
+// readChunked reads a message in chunked framing.
+func readChunked(r *bufio.Reader) (string, error) {
+	var sb strings.Builder
+	for {
+		if _, err := readUntil(r, "\n#"); err != nil {
+			return "", err
+		}
+		header, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		header = strings.TrimSuffix(header, "\n")
+		if header == "#" {
+			return sb.String(), nil
+		}
+		size, err := strconv.Atoi(header)
+		if err != nil {
+			return "", err
+		}
+		chunk := make([]byte, size)
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return "", err
+		}
+		sb.Write(chunk)
+	}
+}
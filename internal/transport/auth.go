@@ -0,0 +1,187 @@
+package transport
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Authenticator applies credentials to outgoing RESTCONF requests.
+type Authenticator interface {
+	// Authenticate sets the authentication headers on req.
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// Refresher is implemented by authenticators that can renew their credentials.
+// The client calls Refresh once after an HTTP 401 response before retrying the request.
+type Refresher interface {
+	Refresh(ctx context.Context) error
+}
+
+// CredentialProvider returns a username and password pair on demand.
+// Implementations may read from the environment, files or an external secret store.
+type CredentialProvider func(ctx context.Context) (username, password string, err error)
+
+// BasicTokenAuth authenticates with a pre-encoded base64 Basic Auth token.
+type BasicTokenAuth struct {
+	token string
+}
+
+// NewBasicTokenAuth creates an authenticator for a pre-encoded base64 token.
+func NewBasicTokenAuth(token string) *BasicTokenAuth {
+	return &BasicTokenAuth{token: token}
+}
+
+// Authenticate sets the Basic Authorization header.
+func (a *BasicTokenAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set(HTTPHeaderKeyAuthorization, HTTPHeaderValueBasicPrefix+a.token)
+	return nil
+}
+
+// CredentialAuth authenticates with Basic Auth credentials obtained from a CredentialProvider.
+// Credentials are cached after the first lookup and fetched again on Refresh.
+type CredentialAuth struct {
+	provider CredentialProvider
+	mu       sync.RWMutex
+	token    string
+}
+
+// NewCredentialAuth creates an authenticator backed by the given credential provider.
+func NewCredentialAuth(provider CredentialProvider) *CredentialAuth {
+	return &CredentialAuth{provider: provider}
+}
+
+// NewBasicAuth creates an authenticator for a static username and password.
+func NewBasicAuth(username, password string) *CredentialAuth {
+	return NewCredentialAuth(StaticCredentials(username, password))
+}
+
+// Authenticate sets the Basic Authorization header, loading credentials on first use.
+func (a *CredentialAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	a.mu.RLock()
+	token := a.token
+	a.mu.RUnlock()
+
+	if token == "" {
+		if err := a.Refresh(ctx); err != nil {
+			return err
+		}
+		a.mu.RLock()
+		token = a.token
+		a.mu.RUnlock()
+	}
+
+	req.Header.Set(HTTPHeaderKeyAuthorization, HTTPHeaderValueBasicPrefix+token)
+	return nil
+}
+
+// Refresh fetches the credentials again from the provider.
+func (a *CredentialAuth) Refresh(ctx context.Context) error {
+	if a.provider == nil {
+		return errors.New("credential provider is not configured")
+	}
+	username, password, err := a.provider(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to obtain credentials: %w", err)
+	}
+	if username == "" || password == "" {
+		return errors.New("credential validation failed: username and password must not be empty")
+	}
+
+	a.mu.Lock()
+	a.token = EncodeBasicToken(username, password)
+	a.mu.Unlock()
+	return nil
+}
+
+// EncodeBasicToken returns the base64 encoding of "username:password".
+func EncodeBasicToken(username, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+}
+
+// StaticCredentials returns a provider that always yields the given credentials.
+func StaticCredentials(username, password string) CredentialProvider {
+	return func(context.Context) (string, string, error) {
+		return username, password, nil
+	}
+}
+
+// EnvCredentials returns a provider that reads credentials from the named environment variables.
+func EnvCredentials(usernameVar, passwordVar string) CredentialProvider {
+	return func(context.Context) (string, string, error) {
+		username, password := os.Getenv(usernameVar), os.Getenv(passwordVar)
+		if username == "" || password == "" {
+			return "", "", fmt.Errorf("environment variables %s and %s must be set", usernameVar, passwordVar)
+		}
+		return username, password, nil
+	}
+}
+
+// FileCredentials returns a provider that reads "username:password" from the first line of a file.
+// The file is read on every refresh so rotated credentials are picked up after a 401.
+func FileCredentials(path string) CredentialProvider {
+	return func(context.Context) (string, string, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read credentials file: %w", err)
+		}
+		line, _, _ := strings.Cut(string(data), "\n")
+		username, password, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			return "", "", errors.New("credentials file must contain username:password")
+		}
+		return username, password, nil
+	}
+}
+
+// SessionJar is a cookie jar that remembers controller session cookies and can be reset
+// when the session is rejected.
+type SessionJar struct {
+	mu  sync.RWMutex
+	jar *cookiejar.Jar
+}
+
+// NewSessionJar creates an empty session jar.
+func NewSessionJar() *SessionJar {
+	return &SessionJar{jar: newCookieJar()}
+}
+
+// SetCookies implements http.CookieJar.
+func (j *SessionJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	j.jar.SetCookies(u, cookies)
+}
+
+// Cookies implements http.CookieJar.
+func (j *SessionJar) Cookies(u *url.URL) []*http.Cookie {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.jar.Cookies(u)
+}
+
+// HasSession reports whether the jar holds cookies for u.
+func (j *SessionJar) HasSession(u *url.URL) bool {
+	return len(j.Cookies(u)) > 0
+}
+
+// Reset discards all stored cookies.
+func (j *SessionJar) Reset() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.jar = newCookieJar()
+}
+
+// newCookieJar creates a cookie jar without a public suffix list, which is not needed
+// for a client bound to a single controller.
+func newCookieJar() *cookiejar.Jar {
+	jar, _ := cookiejar.New(nil) // cookiejar.New never fails with nil options
+	return jar
+}
@@ -0,0 +1,114 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+func TestAuthUnit_BasicTokenAuth_Success(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://controller.example.com", http.NoBody)
+	err := NewBasicTokenAuth("dGVzdDp0ZXN0").Authenticate(context.Background(), req)
+	testutil.AssertNoError(t, err, "Authenticate")
+	testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyAuthorization), "Basic dGVzdDp0ZXN0", "Authorization header")
+}
+
+func TestAuthUnit_BasicAuth_Success(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://controller.example.com", http.NoBody)
+	err := NewBasicAuth("admin", "password").Authenticate(context.Background(), req)
+	testutil.AssertNoError(t, err, "Authenticate")
+	testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyAuthorization),
+		"Basic YWRtaW46cGFzc3dvcmQ=", "Authorization header")
+}
+
+func TestAuthUnit_CredentialAuth_Refresh(t *testing.T) {
+	calls := 0
+	auth := NewCredentialAuth(func(context.Context) (string, string, error) {
+		calls++
+		if calls == 1 {
+			return "admin", "old", nil
+		}
+		return "admin", "new", nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://controller.example.com", http.NoBody)
+	testutil.AssertNoError(t, auth.Authenticate(context.Background(), req), "first Authenticate")
+	testutil.AssertNoError(t, auth.Authenticate(context.Background(), req), "cached Authenticate")
+	testutil.AssertIntEquals(t, calls, 1, "provider should be called once before refresh")
+
+	testutil.AssertNoError(t, auth.Refresh(context.Background()), "Refresh")
+	testutil.AssertNoError(t, auth.Authenticate(context.Background(), req), "Authenticate after refresh")
+	testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyAuthorization),
+		HTTPHeaderValueBasicPrefix+EncodeBasicToken("admin", "new"), "refreshed Authorization header")
+}
+
+func TestAuthUnit_CredentialAuth_Errors(t *testing.T) {
+	t.Run("ProviderError", func(t *testing.T) {
+		auth := NewCredentialAuth(func(context.Context) (string, string, error) {
+			return "", "", errors.New("vault unavailable")
+		})
+		req, _ := http.NewRequest(http.MethodGet, "https://controller.example.com", http.NoBody)
+		err := auth.Authenticate(context.Background(), req)
+		testutil.AssertErrorContains(t, err, "vault unavailable", "provider error")
+	})
+
+	t.Run("EmptyCredentials", func(t *testing.T) {
+		err := NewBasicAuth("", "").Refresh(context.Background())
+		testutil.AssertErrorContains(t, err, "must not be empty", "empty credentials")
+	})
+
+	t.Run("NilProvider", func(t *testing.T) {
+		err := NewCredentialAuth(nil).Refresh(context.Background())
+		testutil.AssertError(t, err, "nil provider")
+	})
+}
+
+func TestAuthUnit_EnvCredentials(t *testing.T) {
+	t.Setenv("WNC_TEST_USER", "admin")
+	t.Setenv("WNC_TEST_PASS", "secret")
+
+	username, password, err := EnvCredentials("WNC_TEST_USER", "WNC_TEST_PASS")(context.Background())
+	testutil.AssertNoError(t, err, "EnvCredentials")
+	testutil.AssertStringEquals(t, username, "admin", "username")
+	testutil.AssertStringEquals(t, password, "secret", "password")
+
+	_, _, err = EnvCredentials("WNC_TEST_UNSET_USER", "WNC_TEST_PASS")(context.Background())
+	testutil.AssertError(t, err, "unset environment variable")
+}
+
+func TestAuthUnit_FileCredentials(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid")
+	invalid := filepath.Join(dir, "invalid")
+	testutil.AssertNoError(t, os.WriteFile(valid, []byte("admin:p@ss:word\n"), 0o600), "write valid file")
+	testutil.AssertNoError(t, os.WriteFile(invalid, []byte("admin\n"), 0o600), "write invalid file")
+
+	username, password, err := FileCredentials(valid)(context.Background())
+	testutil.AssertNoError(t, err, "FileCredentials")
+	testutil.AssertStringEquals(t, username, "admin", "username")
+	testutil.AssertStringEquals(t, password, "p@ss:word", "password keeps colons")
+
+	_, _, err = FileCredentials(invalid)(context.Background())
+	testutil.AssertError(t, err, "missing separator")
+
+	_, _, err = FileCredentials(filepath.Join(dir, "missing"))(context.Background())
+	testutil.AssertError(t, err, "missing file")
+}
+
+func TestAuthUnit_SessionJar(t *testing.T) {
+	jar := NewSessionJar()
+	u, _ := url.Parse("https://controller.example.com/restconf/data")
+
+	testutil.AssertFalse(t, jar.HasSession(u), "empty jar")
+	jar.SetCookies(u, []*http.Cookie{{Name: "session", Value: "abc", Path: "/"}})
+	testutil.AssertTrue(t, jar.HasSession(u), "jar with session cookie")
+
+	jar.Reset()
+	testutil.AssertFalse(t, jar.HasSession(u), "jar after reset")
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf"
 )
@@ -16,19 +17,45 @@ import (
 // RequestBuilder provides HTTP request creation utilities.
 type RequestBuilder struct {
 	restBuilder *restconf.Builder
-	token       string
+	auth        Authenticator
+	session     *SessionJar
+	userAgent   string
+	headers     http.Header
 	logger      *slog.Logger
 }
 
-// NewRequestBuilder creates a new RequestBuilder instance.
+// NewRequestBuilder creates a new RequestBuilder instance using a pre-encoded Basic Auth token.
 func NewRequestBuilder(restBuilder *restconf.Builder, token string, logger *slog.Logger) *RequestBuilder {
 	return &RequestBuilder{
 		restBuilder: restBuilder,
-		token:       token,
+		auth:        NewBasicTokenAuth(token),
+		userAgent:   HTTPHeaderUserAgent,
+		headers:     make(http.Header),
 		logger:      logger,
 	}
 }
 
+// SetAuthenticator replaces the authenticator used to sign requests.
+func (rb *RequestBuilder) SetAuthenticator(auth Authenticator) {
+	rb.auth = auth
+}
+
+// SetUserAgent overrides the User-Agent header sent with every request.
+func (rb *RequestBuilder) SetUserAgent(userAgent string) {
+	rb.userAgent = userAgent
+}
+
+// SetHeader sets an extra header sent with every request, replacing any previous value for key.
+func (rb *RequestBuilder) SetHeader(key, value string) {
+	rb.headers.Set(key, value)
+}
+
+// SetSessionJar enables session reuse: requests carrying a session cookie skip the
+// Authorization header so that the controller does not re-run AAA for every call.
+func (rb *RequestBuilder) SetSessionJar(jar *SessionJar) {
+	rb.session = jar
+}
+
 // CreateRequest creates and configures an HTTP request.
 func (rb *RequestBuilder) CreateRequest(ctx context.Context, method, path string) (*http.Request, error) {
 	if rb.restBuilder == nil {
@@ -43,7 +70,9 @@ func (rb *RequestBuilder) CreateRequest(ctx context.Context, method, path string
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header = DefaultHeaders(rb.token)
+	if err := rb.applyHeaders(ctx, req); err != nil {
+		return nil, err
+	}
 	rb.logger.Debug("Sending API request", "method", method, "url", url)
 	return req, nil
 }
@@ -87,7 +116,9 @@ func (rb *RequestBuilder) CreateRPCRequestWithPayload(
 			rb.logger.Error("Failed to create HTTP RPC request", "error", err, "url", url)
 			return nil, fmt.Errorf("failed to create RPC request: %w", err)
 		}
-		req.Header = DefaultHeaders(rb.token)
+		if err := rb.applyHeaders(ctx, req); err != nil {
+			return nil, err
+		}
 		rb.logger.Debug("Sending RPC request", "method", method, "url", url)
 		return req, nil
 	}
@@ -129,8 +160,30 @@ func (rb *RequestBuilder) createRequestWithJSONPayload(
 		return nil, fmt.Errorf("failed to create "+logType+" request: %w", err)
 	}
 
-	req.Header = DefaultHeaders(rb.token)
+	if err := rb.applyHeaders(ctx, req); err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", HTTPHeaderValueYANGData)
 	rb.logger.Debug("Sending "+logType+" request", "method", method, "url", url)
 	return req, nil
 }
+
+// applyHeaders sets the default headers and authentication on req.
+func (rb *RequestBuilder) applyHeaders(ctx context.Context, req *http.Request) error {
+	req.Header = baseHeaders()
+	for key, values := range rb.headers {
+		req.Header[key] = slices.Clone(values)
+	}
+	req.Header.Set(HTTPHeaderKeyUserAgent, rb.userAgent)
+	if rb.session != nil && rb.session.HasSession(req.URL) {
+		return nil
+	}
+	if rb.auth == nil {
+		return errors.New("authenticator is not configured")
+	}
+	if err := rb.auth.Authenticate(ctx, req); err != nil {
+		rb.logger.Error("Failed to authenticate request", "error", err, "url", req.URL.String())
+		return fmt.Errorf("failed to authenticate request: %w", err)
+	}
+	return nil
+}
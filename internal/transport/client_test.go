@@ -271,3 +271,42 @@ func TestClientUnit_ExecuteRequest_ErrorScenarios(t *testing.T) {
 		testutil.AssertIntEquals(t, resp.StatusCode, http.StatusNotFound, "Status code should be 404")
 	})
 }
+
+// Test custom User-Agent and extra headers.
+func TestClientUnit_RequestBuilderCustomHeaders_Success(t *testing.T) {
+	restBuilder := restconf.NewBuilder("https", "controller.example.com")
+	rb := NewRequestBuilder(restBuilder, "token", slog.Default())
+
+	t.Run("DefaultUserAgent", func(t *testing.T) {
+		req, err := rb.CreateRequest(context.Background(), http.MethodGet, "test/path")
+		testutil.AssertNoError(t, err, "CreateRequest")
+		testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyUserAgent), HTTPHeaderUserAgent, "User-Agent")
+	})
+
+	rb.SetUserAgent("collector/2.3")
+	rb.SetHeader("X-Correlation-ID", "abc-123")
+	rb.SetHeader("Proxy-Authorization", "Basic cHJveHk6cHJveHk=")
+
+	t.Run("CustomHeadersOnRequest", func(t *testing.T) {
+		req, err := rb.CreateRequest(context.Background(), http.MethodGet, "test/path")
+		testutil.AssertNoError(t, err, "CreateRequest")
+		testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyUserAgent), "collector/2.3", "User-Agent")
+		testutil.AssertStringEquals(t, req.Header.Get("X-Correlation-ID"), "abc-123", "X-Correlation-ID")
+		testutil.AssertStringEquals(t, req.Header.Get("Proxy-Authorization"), "Basic cHJveHk6cHJveHk=",
+			"Proxy-Authorization")
+		testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyAuthorization), "Basic token", "Authorization")
+	})
+
+	t.Run("CustomHeadersOnPayloadAndRPC", func(t *testing.T) {
+		req, err := rb.CreateRequestWithPayload(context.Background(), http.MethodPut, "test/path",
+			map[string]string{"k": "v"})
+		testutil.AssertNoError(t, err, "CreateRequestWithPayload")
+		testutil.AssertStringEquals(t, req.Header.Get("X-Correlation-ID"), "abc-123", "payload X-Correlation-ID")
+		testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyContentType), HTTPHeaderValueYANGData,
+			"payload Content-Type")
+
+		req, err = rb.CreateRPCRequestWithPayload(context.Background(), http.MethodPost, "test/rpc", nil)
+		testutil.AssertNoError(t, err, "CreateRPCRequestWithPayload")
+		testutil.AssertStringEquals(t, req.Header.Get(HTTPHeaderKeyUserAgent), "collector/2.3", "RPC User-Agent")
+	})
+}
@@ -0,0 +1,117 @@
+package transport
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// SPKIPinPrefix is the optional prefix of SPKI pins, as used by HPKP and curl --pinnedpubkey.
+const SPKIPinPrefix = "sha256/"
+
+// TLSSettings holds the TLS parameters used to build the client transport.
+type TLSSettings struct {
+	InsecureSkipVerify bool              // Skip certificate chain and host name verification
+	RootCAs            *x509.CertPool    // Trusted root CAs, nil uses the system pool
+	Certificates       []tls.Certificate // Client certificates for mutual TLS
+	MinVersion         uint16            // Minimum TLS version, zero uses the Go default
+	ServerName         string            // Overrides the server name used for verification and SNI
+	SPKIPins           [][]byte          // SHA-256 digests of accepted SubjectPublicKeyInfo
+}
+
+// Config builds a tls.Config from the settings.
+func (s TLSSettings) Config() *tls.Config {
+	cfg := &tls.Config{
+		InsecureSkipVerify: s.InsecureSkipVerify, //nolint:gosec
+		RootCAs:            s.RootCAs,
+		Certificates:       s.Certificates,
+		MinVersion:         s.MinVersion,
+		ServerName:         s.ServerName,
+	}
+	if len(s.SPKIPins) > 0 {
+		pins := slices.Clone(s.SPKIPins)
+		skipVerify := s.InsecureSkipVerify
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifySPKIPins(pinnableCertificates(cs, skipVerify), pins)
+		}
+	}
+	return cfg
+}
+
+// NewCertPoolFromPEM creates a certificate pool from PEM encoded CA certificates.
+func NewCertPoolFromPEM(pemData []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, errors.New("no valid PEM certificates found")
+	}
+	return pool, nil
+}
+
+// ParseSPKIPin decodes a base64 SHA-256 SPKI pin, optionally prefixed with "sha256/".
+func ParseSPKIPin(pin string) ([]byte, error) {
+	digest, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(pin, SPKIPinPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SPKI pin %q: %w", pin, err)
+	}
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid SPKI pin %q: expected %d byte SHA-256 digest, got %d",
+			pin, sha256.Size, len(digest))
+	}
+	return digest, nil
+}
+
+// SPKIPin returns the "sha256/<base64>" pin of the certificate public key.
+func SPKIPin(cert *x509.Certificate) string {
+	digest := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return SPKIPinPrefix + base64.StdEncoding.EncodeToString(digest[:])
+}
+
+// pinnableCertificates returns the certificates a pin may match. Without chain verification the
+// peer only proves possession of the leaf key, so the other certificates it sent cannot be trusted.
+func pinnableCertificates(cs tls.ConnectionState, skipVerify bool) []*x509.Certificate {
+	if skipVerify {
+		if len(cs.PeerCertificates) == 0 {
+			return nil
+		}
+		return cs.PeerCertificates[:1]
+	}
+	var certs []*x509.Certificate
+	for _, chain := range cs.VerifiedChains {
+		certs = append(certs, chain...)
+	}
+	return certs
+}
+
+// verifySPKIPins succeeds when any of the given certificates matches a pin.
+func verifySPKIPins(certs []*x509.Certificate, pins [][]byte) error {
+	for _, cert := range certs {
+		digest := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		for _, pin := range pins {
+			if string(digest[:]) == string(pin) {
+				return nil
+			}
+		}
+	}
+	return errors.New("TLS verification failed: no verified peer certificate matches the configured SPKI pins")
+}
+
+// NewTransportWithTLS creates and configures a new HTTP transport with the given TLS settings.
+func NewTransportWithTLS(settings TLSSettings) *http.Transport {
+	return &http.Transport{
+		TLSClientConfig:       settings.Config(),
+		ForceAttemptHTTP2:     false,
+		DisableKeepAlives:     false,
+		DisableCompression:    false,
+		TLSHandshakeTimeout:   DefaultTLSHandshakeTimeout,
+		ResponseHeaderTimeout: DefaultResponseHeaderTimeout,
+		IdleConnTimeout:       DefaultIdleConnTimeout,
+		MaxIdleConns:          DefaultMaxIdleConns,
+		MaxIdleConnsPerHost:   DefaultMaxIdleConnsPerHost,
+	}
+}
@@ -2,7 +2,6 @@
 package transport
 
 import (
-	"crypto/tls"
 	"net/http"
 	"time"
 )
@@ -25,6 +24,10 @@ const (
 	HTTPHeaderValueBasicPrefix = "Basic "
 	// HTTPHeaderValueYANGData defines the YANG data content type.
 	HTTPHeaderValueYANGData = "application/yang-data+json"
+	// HTTPHeaderValueYANGDataXML defines the YANG data content type of XML encoded bodies.
+	HTTPHeaderValueYANGDataXML = "application/yang-data+xml"
+	// HTTPHeaderValueYANGPatch defines the YANG Patch content type (RFC 8072).
+	HTTPHeaderValueYANGPatch = "application/yang-patch+json"
 	// HTTPHeaderUserAgent defines the User-Agent string.
 	HTTPHeaderUserAgent = "wnc-go-client/1.0"
 	// HTTPHeaderAccept defines the default Accept header value.
@@ -53,25 +56,19 @@ const (
 
 // NewTransport creates and configures a new HTTP transport with the specified TLS settings.
 func NewTransport(skipVerify bool) *http.Transport {
-	return &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: skipVerify, //nolint:gosec
-		},
-		ForceAttemptHTTP2:     false,
-		DisableKeepAlives:     false,
-		DisableCompression:    false,
-		TLSHandshakeTimeout:   DefaultTLSHandshakeTimeout,
-		ResponseHeaderTimeout: DefaultResponseHeaderTimeout,
-		IdleConnTimeout:       DefaultIdleConnTimeout,
-		MaxIdleConns:          DefaultMaxIdleConns,
-		MaxIdleConnsPerHost:   DefaultMaxIdleConnsPerHost,
-	}
+	return NewTransportWithTLS(TLSSettings{InsecureSkipVerify: skipVerify})
 }
 
 // DefaultHeaders returns a pre-configured header map with authentication and content type.
 func DefaultHeaders(token string) http.Header {
-	headers := make(http.Header)
+	headers := baseHeaders()
 	headers.Set(HTTPHeaderKeyAuthorization, HTTPHeaderValueBasicPrefix+token)
+	return headers
+}
+
+// baseHeaders returns the default headers without authentication.
+func baseHeaders() http.Header {
+	headers := make(http.Header)
 	headers.Set(HTTPHeaderKeyAccept, HTTPHeaderValueYANGData)
 	headers.Set(HTTPHeaderKeyUserAgent, HTTPHeaderUserAgent)
 	return headers
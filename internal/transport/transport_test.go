@@ -1,6 +1,9 @@
 package transport
 
 import (
+	"crypto/tls"
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
@@ -109,3 +112,41 @@ func TestTransportUnit_NewTransportDetailsConfiguration_Success(t *testing.T) {
 		"MaxIdleConnsPerHost",
 	)
 }
+
+func TestTransportUnit_NewTransportWithTLS_Success(t *testing.T) {
+	pin := make([]byte, 32)
+	settings := TLSSettings{
+		MinVersion: tls.VersionTLS13,
+		ServerName: "wnc.example.internal",
+		SPKIPins:   [][]byte{pin},
+	}
+	transport := NewTransportWithTLS(settings)
+
+	testutil.AssertFalse(t, transport.TLSClientConfig.InsecureSkipVerify, "InsecureSkipVerify")
+	testutil.AssertIntEquals(t, int(transport.TLSClientConfig.MinVersion), tls.VersionTLS13, "MinVersion")
+	testutil.AssertStringEquals(t, transport.TLSClientConfig.ServerName, "wnc.example.internal", "ServerName")
+	testutil.AssertNotNil(t, transport.TLSClientConfig.VerifyConnection, "VerifyConnection for pins")
+
+	err := transport.TLSClientConfig.VerifyConnection(tls.ConnectionState{})
+	testutil.AssertError(t, err, "no peer certificates should fail pin verification")
+}
+
+func TestTransportUnit_ParseSPKIPin_Success(t *testing.T) {
+	valid := "sha256/" + base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	digest, err := ParseSPKIPin(valid)
+	testutil.AssertNoError(t, err, "prefixed pin")
+	testutil.AssertIntEquals(t, len(digest), 32, "digest length")
+
+	_, err = ParseSPKIPin(strings.TrimPrefix(valid, SPKIPinPrefix))
+	testutil.AssertNoError(t, err, "unprefixed pin")
+
+	_, err = ParseSPKIPin("sha256/AAAA")
+	testutil.AssertError(t, err, "short digest")
+
+	_, err = ParseSPKIPin("%%%")
+	testutil.AssertError(t, err, "invalid base64")
+
+	_, err = NewCertPoolFromPEM([]byte("garbage"))
+	testutil.AssertError(t, err, "invalid PEM bundle")
+}
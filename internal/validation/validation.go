@@ -72,6 +72,19 @@ func IsValidTimeout(timeout time.Duration) bool {
 	return timeout > 0
 }
 
+// IsValidHeaderName checks if name is a valid HTTP header field name (RFC 9110 token).
+func IsValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r > 0x7e || r <= 0x20 || strings.ContainsRune("\"(),/:;<=>?@[\\]{}", r) {
+			return false
+		}
+	}
+	return true
+}
+
 // ValidateNonEmptyString validates that a string is not empty after trimming whitespace.
 func ValidateNonEmptyString(s, fieldName string) error {
 	if !IsNonEmptyString(s) {
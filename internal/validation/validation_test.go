@@ -32,6 +32,12 @@ func TestValidationUnit_GetOperations_Success(t *testing.T) {
 	testutil.AssertBoolEquals(t, IsValidAccessToken(""), false, "empty token")
 	testutil.AssertBoolEquals(t, IsValidAccessToken("short"), true, "short token is valid (only checks non-empty)")
 
+	// Header name validation
+	testutil.AssertBoolEquals(t, IsValidHeaderName("X-Correlation-ID"), true, "valid header name")
+	testutil.AssertBoolEquals(t, IsValidHeaderName(""), false, "empty header name")
+	testutil.AssertBoolEquals(t, IsValidHeaderName("X Header"), false, "header name with space")
+	testutil.AssertBoolEquals(t, IsValidHeaderName("X-Header:"), false, "header name with colon")
+
 	// MAC validation
 	testutil.AssertBoolEquals(t, IsValidMACAddr("00:11:22:33:44:55"), true, "valid colon MAC")
 	testutil.AssertBoolEquals(t, IsValidMACAddr("00-11-22-33-44-55"), true, "valid hyphen MAC")
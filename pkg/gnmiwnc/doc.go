@@ -0,0 +1,19 @@
+// Package gnmiwnc streams model-driven telemetry from the controller over gNMI Subscribe (dial-in).
+//
+// Subscriptions use the same YANG paths as the RESTCONF routes and decode each update into the
+// existing service structs, so operational data such as client, AP CAPWAP or rogue entries can
+// be followed without polling:
+//
+//	sub, err := gnmiwnc.NewSubscriber(host, gnmiwnc.WithCredentials(user, pass))
+//	defer sub.Close()
+//	for update, err := range gnmiwnc.SubscribeClients(ctx, sub, gnmiwnc.OnChange()) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(update.Value.ClientMAC, update.Deleted)
+//	}
+//
+// ON_CHANGE and SAMPLE modes are supported. Interrupted streams are re-established with
+// exponential backoff until the context is canceled, the controller rejects the subscription, or
+// the reconnection attempts set by WithMaxReconnectAttempts fail in a row.
+package gnmiwnc
@@ -0,0 +1,208 @@
+package gnmiwnc
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/validation"
+)
+
+// Subscriber default values.
+const (
+	// DefaultPort is the IOS-XE gNMI port with TLS (gnxi secure-port).
+	DefaultPort = 9339
+	// DefaultMinBackoff is the delay before the first reconnection attempt.
+	DefaultMinBackoff = time.Second
+	// DefaultMaxBackoff caps the delay between reconnection attempts.
+	DefaultMaxBackoff = time.Minute
+	// DefaultMaxReconnectAttempts is the number of consecutive failed reconnection attempts
+	// after which a subscription ends with an error.
+	DefaultMaxReconnectAttempts = 10
+)
+
+// gRPC metadata keys carrying the credentials, as expected by IOS-XE.
+const (
+	metadataUsername = "username"
+	metadataPassword = "password"
+)
+
+// config holds the subscriber settings.
+type config struct {
+	port        int
+	tlsConfig   *tls.Config
+	insecure    bool
+	credentials wnc.CredentialProvider
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+	logger      *slog.Logger
+	dialOptions []grpc.DialOption
+}
+
+// Option configures a Subscriber.
+type Option func(*config) error
+
+// WithPort sets the gNMI port, DefaultPort by default.
+func WithPort(port int) Option {
+	return func(c *config) error {
+		if port <= 0 || port > 65535 {
+			return fmt.Errorf("port validation failed: invalid port %d", port)
+		}
+		c.port = port
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration, such as trusted CAs or client certificates.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *config) error {
+		if tlsConfig == nil {
+			return errors.New("TLS configuration cannot be nil")
+		}
+		c.tlsConfig = tlsConfig
+		return nil
+	}
+}
+
+// WithInsecureTransport connects without TLS, for controllers serving gNMI in plaintext.
+func WithInsecureTransport() Option {
+	return func(c *config) error {
+		c.insecure = true
+		return nil
+	}
+}
+
+// WithCredentials authenticates every subscription with username and password.
+func WithCredentials(username, password string) Option {
+	return func(c *config) error {
+		if username == "" || password == "" {
+			return errors.New("username and password must not be empty")
+		}
+		c.credentials = transport.StaticCredentials(username, password)
+		return nil
+	}
+}
+
+// WithCredentialProvider authenticates with credentials looked up on every (re)connection,
+// so that rotated passwords are picked up.
+func WithCredentialProvider(provider wnc.CredentialProvider) Option {
+	return func(c *config) error {
+		if provider == nil {
+			return errors.New("credential provider cannot be nil")
+		}
+		c.credentials = provider
+		return nil
+	}
+}
+
+// WithReconnectBackoff sets the delays between reconnection attempts, doubling from minimum
+// up to maximum. The delay is reset once a stream delivers data.
+func WithReconnectBackoff(minimum, maximum time.Duration) Option {
+	return func(c *config) error {
+		if minimum <= 0 || maximum < minimum {
+			return fmt.Errorf("backoff validation failed: invalid range %v to %v", minimum, maximum)
+		}
+		c.minBackoff, c.maxBackoff = minimum, maximum
+		return nil
+	}
+}
+
+// WithMaxReconnectAttempts ends a subscription with the last error once attempts consecutive
+// reconnection attempts failed without delivering data, DefaultMaxReconnectAttempts by default.
+// Zero reconnects until the context is canceled.
+func WithMaxReconnectAttempts(attempts int) Option {
+	return func(c *config) error {
+		if attempts < 0 {
+			return fmt.Errorf("reconnect attempts validation failed: invalid count %d", attempts)
+		}
+		c.maxAttempts = attempts
+		return nil
+	}
+}
+
+// WithLogger sets the logger reporting reconnections, slog.Default() by default.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) error {
+		if logger == nil {
+			return errors.New("logger cannot be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithDialOptions appends gRPC dial options, for example interceptors.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *config) error {
+		c.dialOptions = append(c.dialOptions, opts...)
+		return nil
+	}
+}
+
+// Subscriber opens gNMI subscriptions to one controller over a shared gRPC connection.
+type Subscriber struct {
+	conn   *grpc.ClientConn
+	client gpb.GNMIClient
+	cfg    config
+}
+
+// NewSubscriber creates a subscriber for the controller at host ("host" or "host:port").
+// The connection is established lazily by the first subscription.
+func NewSubscriber(host string, opts ...Option) (*Subscriber, error) {
+	if !validation.IsValidController(host) {
+		return nil, fmt.Errorf("subscriber initialization failed: %w",
+			fmt.Errorf("controller address validation failed: invalid format %s", host))
+	}
+	cfg := config{
+		port:        DefaultPort,
+		minBackoff:  DefaultMinBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+		maxAttempts: DefaultMaxReconnectAttempts,
+		logger:      slog.Default(),
+	}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, fmt.Errorf("subscriber initialization failed: %w", err)
+		}
+	}
+
+	address := host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		address = net.JoinHostPort(host, strconv.Itoa(cfg.port))
+	}
+	creds := insecure.NewCredentials()
+	if !cfg.insecure {
+		tlsConfig := cfg.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, cfg.dialOptions...)
+	conn, err := grpc.NewClient(address, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("subscriber initialization failed: %w", err)
+	}
+	return &Subscriber{conn: conn, client: gpb.NewGNMIClient(conn), cfg: cfg}, nil
+}
+
+// Close closes the gRPC connection, ending all subscriptions.
+func (s *Subscriber) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
@@ -0,0 +1,210 @@
+package gnmiwnc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// clientNotification returns a notification updating the client entry mac with a JSON_IETF value.
+func clientNotification(mac, value string) *gpb.SubscribeResponse {
+	prefix := &gpb.Path{
+		Origin: "rfc7951",
+		Elem:   []*gpb.PathElem{{Name: "Cisco-IOS-XE-wireless-client-oper:client-oper-data"}},
+	}
+	path := &gpb.Path{Elem: []*gpb.PathElem{{Name: "common-oper-data", Key: map[string]string{"client-mac": mac}}}}
+	return &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).UnixNano(),
+		Prefix:    prefix,
+		Update: []*gpb.Update{{
+			Path: path,
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(value)}},
+		}},
+	}}}
+}
+
+// clientEntry mirrors the client common operational data members used by the tests.
+type clientEntry struct {
+	ClientMAC string `json:"client-mac"`
+	APName    string `json:"ap-name,omitempty"`
+}
+
+// sendFunc sends a response on a stand-in Subscribe stream.
+type sendFunc = func(*gpb.SubscribeResponse) error
+
+// syncResponse marks the end of the initial snapshot.
+var syncResponse = &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}}
+
+// newTestSubscriber returns a subscriber connected to server with fast reconnection and opts.
+func newTestSubscriber(t *testing.T, server *testutil.GNMIServer, opts ...Option) *Subscriber {
+	t.Helper()
+	sub, err := NewSubscriber(server.Addr, append([]Option{
+		WithInsecureTransport(),
+		WithCredentials("admin", "secret"),
+		WithReconnectBackoff(time.Millisecond, 10*time.Millisecond),
+		WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))),
+	}, opts...)...)
+	testutil.AssertNoError(t, err, "NewSubscriber")
+	t.Cleanup(func() { _ = sub.Close() })
+	return sub
+}
+
+func TestGNMIWNCUnit_NewSubscriber_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		host string
+		opts []Option
+	}{
+		{"EmptyHost", "", nil},
+		{"InvalidPort", "wnc.example.com", []Option{WithPort(0)}},
+		{"NilTLSConfig", "wnc.example.com", []Option{WithTLSConfig(nil)}},
+		{"EmptyCredentials", "wnc.example.com", []Option{WithCredentials("", "")}},
+		{"NilProvider", "wnc.example.com", []Option{WithCredentialProvider(nil)}},
+		{"InvalidBackoff", "wnc.example.com", []Option{WithReconnectBackoff(time.Second, time.Millisecond)}},
+		{"InvalidReconnectAttempts", "wnc.example.com", []Option{WithMaxReconnectAttempts(-1)}},
+		{"NilLogger", "wnc.example.com", []Option{WithLogger(nil)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSubscriber(tt.host, tt.opts...)
+			testutil.AssertError(t, err, tt.name)
+		})
+	}
+
+	sub, err := NewSubscriber("wnc.example.com", WithPort(50052))
+	testutil.AssertNoError(t, err, "valid subscriber")
+	testutil.AssertStringEquals(t, sub.conn.Target(), "wnc.example.com:50052", "port applied")
+	testutil.AssertNoError(t, sub.Close(), "Close")
+}
+
+func TestGNMIWNCUnit_Subscribe_OnChange(t *testing.T) {
+	server := testutil.NewGNMIServer(t, func(_ int, _ *gpb.SubscribeRequest, send sendFunc) error {
+		_ = send(clientNotification("aa:bb", `{"ap-name":"ap1","ms-ap-slot-id":1}`))
+		_ = send(syncResponse)
+		prefix := &gpb.Path{Elem: []*gpb.PathElem{{Name: "Cisco-IOS-XE-wireless-client-oper:client-oper-data"}}}
+		key := map[string]string{"client-mac": "aa:bb"}
+		deleted := &gpb.Path{Elem: []*gpb.PathElem{{Name: "common-oper-data", Key: key}}}
+		return send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+			Prefix: prefix, Delete: []*gpb.Path{deleted},
+		}}})
+	})
+	sub := newTestSubscriber(t, server)
+
+	var updates []Update[clientEntry]
+	for update, err := range Subscribe[clientEntry](context.Background(), sub, RouteClientCommonOperData) {
+		testutil.AssertNoError(t, err, "update")
+		updates = append(updates, update)
+		if len(updates) == 2 {
+			break
+		}
+	}
+
+	testutil.AssertStringEquals(t, updates[0].Value.ClientMAC, "aa:bb", "key merged from path")
+	testutil.AssertStringEquals(t, updates[0].Value.APName, "ap1", "typed entry")
+	testutil.AssertStringEquals(t, updates[0].Path,
+		"/Cisco-IOS-XE-wireless-client-oper:client-oper-data/common-oper-data[client-mac=aa:bb]", "path")
+	testutil.AssertIntEquals(t, updates[0].Timestamp.Year(), 2026, "timestamp")
+	testutil.AssertTrue(t, updates[1].Deleted, "deletion")
+	testutil.AssertStringEquals(t, updates[1].Value.ClientMAC, "aa:bb", "deleted entry keys")
+
+	req := server.Requests()[0].GetSubscribe()
+	testutil.AssertStringEquals(t, req.GetEncoding().String(), "JSON_IETF", "encoding")
+	testutil.AssertStringEquals(t, req.GetSubscription()[0].GetMode().String(), "ON_CHANGE", "mode")
+	md := server.Metadata()[0]
+	testutil.AssertStringEquals(t, md.Get("username")[0], "admin", "username metadata")
+	testutil.AssertStringEquals(t, md.Get("password")[0], "secret", "password metadata")
+}
+
+func TestGNMIWNCUnit_Subscribe_SampleAndReconnect(t *testing.T) {
+	server := testutil.NewGNMIServer(t, func(conn int, _ *gpb.SubscribeRequest, send sendFunc) error {
+		if conn == 1 {
+			_ = send(clientNotification("first", `{}`))
+			return status.Error(codes.Unavailable, "controller switchover")
+		}
+		return send(clientNotification("second", `{}`))
+	})
+	sub := newTestSubscriber(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var macs []string
+	opts := []SubscribeOption{Sample(10 * time.Second), SuppressRedundant(time.Minute)}
+	for update, err := range Subscribe[clientEntry](ctx, sub, RouteClientCommonOperData, opts...) {
+		testutil.AssertNoError(t, err, "update")
+		macs = append(macs, update.Value.ClientMAC)
+		if len(macs) == 2 {
+			break
+		}
+	}
+
+	testutil.AssertIntEquals(t, len(macs), 2, "updates across reconnection")
+	testutil.AssertStringEquals(t, macs[1], "second", "update after reconnection")
+	testutil.AssertIntEquals(t, len(server.Requests()), 2, "subscription re-established")
+	subscription := server.Requests()[1].GetSubscribe().GetSubscription()[0]
+	testutil.AssertStringEquals(t, subscription.GetMode().String(), "SAMPLE", "mode")
+	testutil.AssertTrue(t, subscription.GetSampleInterval() == uint64(10*time.Second), "sample interval")
+	testutil.AssertTrue(t, subscription.GetSuppressRedundant(), "suppress redundant")
+}
+
+func TestGNMIWNCUnit_Subscribe_ReconnectAttemptsExhausted(t *testing.T) {
+	server := testutil.NewGNMIServer(t, func(conn int, _ *gpb.SubscribeRequest, send sendFunc) error {
+		if conn == 1 {
+			_ = send(clientNotification("first", `{}`))
+		}
+		return status.Error(codes.Unavailable, "controller unreachable")
+	})
+	sub := newTestSubscriber(t, server, WithMaxReconnectAttempts(2))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var macs []string
+	var subscribeErr error
+	for update, err := range SubscribeClients(ctx, sub) {
+		if err != nil {
+			subscribeErr = err
+			continue
+		}
+		macs = append(macs, update.Value.ClientMAC)
+	}
+
+	testutil.AssertIntEquals(t, len(macs), 1, "updates before the controller became unreachable")
+	testutil.AssertErrorContains(t, subscribeErr, "failed after 2 reconnection attempts", "exhausted reconnection")
+	testutil.AssertErrorContains(t, subscribeErr, "controller unreachable", "last error")
+	testutil.AssertTrue(t, ctx.Err() == nil, "error yielded before the context deadline")
+	testutil.AssertIntEquals(t, len(server.Requests()), 3, "attempts counted from the last delivered data")
+}
+
+func TestGNMIWNCUnit_Subscribe_PermanentErrors(t *testing.T) {
+	server := testutil.NewGNMIServer(t, func(conn int, _ *gpb.SubscribeRequest, send sendFunc) error {
+		if conn == 1 {
+			return status.Error(codes.InvalidArgument, "unknown path")
+		}
+		return send(clientNotification("x", `"not an object"`))
+	})
+	sub := newTestSubscriber(t, server)
+
+	for _, err := range SubscribeRogues(context.Background(), sub) {
+		testutil.AssertErrorContains(t, err, "unknown path", "rejected subscription")
+	}
+	testutil.AssertIntEquals(t, len(server.Requests()), 1, "no reconnection after rejection")
+
+	for _, err := range SubscribeClients(context.Background(), sub) {
+		testutil.AssertErrorContains(t, err, "failed to decode update", "undecodable update")
+	}
+
+	keyed := RouteAPCapwapData + "=00:11:22:33:44:55"
+	for _, err := range Subscribe[struct{}](context.Background(), sub, keyed) {
+		testutil.AssertErrorContains(t, err, "list keys are not supported", "keyed route")
+	}
+	for _, err := range SubscribeCAPWAPData(context.Background(), nil) {
+		testutil.AssertError(t, err, "nil subscriber")
+	}
+}
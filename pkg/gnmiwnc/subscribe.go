@@ -0,0 +1,262 @@
+package gnmiwnc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/gnmi"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/ap"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/client"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/rogue"
+)
+
+// Routes of the operational lists with typed subscription helpers.
+const (
+	// RouteClientCommonOperData is the client common operational data list.
+	RouteClientCommonOperData = routes.ClientCommonOperDataPath
+	// RouteAPCapwapData is the AP CAPWAP data list.
+	RouteAPCapwapData = routes.APCapwapDataPath
+	// RouteRogueData is the rogue data list.
+	RouteRogueData = routes.RogueDataPath
+)
+
+// DefaultSampleInterval is the SAMPLE mode interval used when none is given.
+const DefaultSampleInterval = 30 * time.Second
+
+// Update is a telemetry update of one list entry or container.
+type Update[T any] struct {
+	Path      string            // gNMI path of the entry, e.g. "/module:data/list[key=value]"
+	Keys      map[string]string // List keys taken from the path
+	Timestamp time.Time         // Time the controller generated the update
+	Value     T                 // Decoded entry; only the changed members for leaf updates
+	Deleted   bool              // True when the entry was removed; Value then holds only the keys
+}
+
+// SubscribeOption configures a single subscription.
+type SubscribeOption func(*gpb.Subscription)
+
+// OnChange sends an update whenever the data changes, after an initial snapshot.
+func OnChange() SubscribeOption {
+	return func(s *gpb.Subscription) {
+		s.Mode = gpb.SubscriptionMode_ON_CHANGE
+		s.SampleInterval = 0
+	}
+}
+
+// Sample sends the data every interval, DefaultSampleInterval when interval is not positive.
+func Sample(interval time.Duration) SubscribeOption {
+	return func(s *gpb.Subscription) {
+		if interval <= 0 {
+			interval = DefaultSampleInterval
+		}
+		s.Mode = gpb.SubscriptionMode_SAMPLE
+		s.SampleInterval = uint64(interval.Nanoseconds())
+	}
+}
+
+// SuppressRedundant skips SAMPLE updates of unchanged data, sending them at least every
+// heartbeat interval when one is given.
+func SuppressRedundant(heartbeat time.Duration) SubscribeOption {
+	return func(s *gpb.Subscription) {
+		s.SuppressRedundant = true
+		if heartbeat > 0 {
+			s.HeartbeatInterval = uint64(heartbeat.Nanoseconds())
+		}
+	}
+}
+
+// Subscribe streams updates of the data at route, such as RouteClientCommonOperData, decoded
+// into T, the type of one list entry or of the container at route. The subscription uses
+// ON_CHANGE mode unless Sample is given.
+//
+// The stream is opened when iteration starts and re-established with backoff after failures.
+// An error is yielded and iteration stops when the route is invalid, the controller rejects the
+// subscription, an update cannot be decoded, or the controller stays unreachable for the number
+// of reconnection attempts set by WithMaxReconnectAttempts. Breaking out of the loop ends the
+// subscription.
+func Subscribe[T any](
+	ctx context.Context, s *Subscriber, route string, opts ...SubscribeOption,
+) iter.Seq2[Update[T], error] {
+	return func(yield func(Update[T], error) bool) {
+		var zero Update[T]
+		if s == nil {
+			yield(zero, errors.New("subscriber cannot be nil"))
+			return
+		}
+		path, err := gnmi.PathFromRoute(route)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		subscription := &gpb.Subscription{Path: path, Mode: gpb.SubscriptionMode_ON_CHANGE}
+		for _, opt := range opts {
+			opt(subscription)
+		}
+		req := &gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+			Subscription: []*gpb.Subscription{subscription},
+			Mode:         gpb.SubscriptionList_STREAM,
+			Encoding:     gpb.Encoding_JSON_IETF,
+		}}}
+		node := path.GetElem()[len(path.GetElem())-1].GetName()
+
+		backoff := s.cfg.minBackoff
+		attempts := 0
+		for {
+			received, err := stream(ctx, s, req, node, yield)
+			switch {
+			case errors.Is(err, errStopped), ctx.Err() != nil:
+				return
+			case isPermanent(err):
+				yield(zero, fmt.Errorf("gNMI subscription to %s failed: %w", route, err))
+				return
+			}
+			if received {
+				backoff = s.cfg.minBackoff
+				attempts = 0
+			}
+			if s.cfg.maxAttempts > 0 && attempts >= s.cfg.maxAttempts {
+				yield(zero, fmt.Errorf("gNMI subscription to %s failed after %d reconnection attempts: %w",
+					route, attempts, err))
+				return
+			}
+			attempts++
+			s.cfg.logger.Warn("gNMI subscription interrupted, reconnecting",
+				"route", route, "error", err, "backoff", backoff)
+
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			backoff = min(backoff*2, s.cfg.maxBackoff)
+		}
+	}
+}
+
+// SubscribeClients streams updates of the client common operational data.
+func SubscribeClients(
+	ctx context.Context, s *Subscriber, opts ...SubscribeOption,
+) iter.Seq2[Update[client.CommonOperData], error] {
+	return Subscribe[client.CommonOperData](ctx, s, RouteClientCommonOperData, opts...)
+}
+
+// SubscribeCAPWAPData streams updates of the AP CAPWAP data.
+func SubscribeCAPWAPData(
+	ctx context.Context, s *Subscriber, opts ...SubscribeOption,
+) iter.Seq2[Update[ap.CAPWAPData], error] {
+	return Subscribe[ap.CAPWAPData](ctx, s, RouteAPCapwapData, opts...)
+}
+
+// SubscribeRogues streams updates of the rogue data.
+func SubscribeRogues(
+	ctx context.Context, s *Subscriber, opts ...SubscribeOption,
+) iter.Seq2[Update[rogue.RogueData], error] {
+	return Subscribe[rogue.RogueData](ctx, s, RouteRogueData, opts...)
+}
+
+// errStopped signals that the consumer stopped the iteration.
+var errStopped = errors.New("iteration stopped")
+
+// errDecode marks update decoding failures, which are not repaired by reconnecting.
+var errDecode = errors.New("failed to decode update")
+
+// stream runs one Subscribe stream until it fails and reports whether it delivered any data.
+func stream[T any](
+	ctx context.Context, s *Subscriber, req *gpb.SubscribeRequest, node string, yield func(Update[T], error) bool,
+) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if s.cfg.credentials != nil {
+		username, password, err := s.cfg.credentials(ctx)
+		if err != nil {
+			return false, status.Error(codes.Unauthenticated, err.Error())
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, metadataUsername, username, metadataPassword, password)
+	}
+
+	sub, err := s.client.Subscribe(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := sub.Send(req); err != nil {
+		_, err = sub.Recv() // The status of a rejected stream is reported by Recv
+		return false, err
+	}
+
+	received := false
+	for {
+		resp, err := sub.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		notification := resp.GetUpdate()
+		if notification == nil {
+			continue // Sync responses mark the end of the initial snapshot
+		}
+		if err := deliver(notification, node, yield); err != nil {
+			return received, err
+		}
+	}
+}
+
+// deliver decodes the updates and deletions of a notification and yields them.
+func deliver[T any](notification *gpb.Notification, node string, yield func(Update[T], error) bool) error {
+	timestamp := time.Unix(0, notification.GetTimestamp())
+	for _, deleted := range notification.GetDelete() {
+		path := gnmi.Join(notification.GetPrefix(), deleted)
+		update := Update[T]{Path: gnmi.PathString(path), Keys: gnmi.Keys(path), Timestamp: timestamp, Deleted: true}
+		if keys, err := json.Marshal(update.Keys); err == nil {
+			_ = json.Unmarshal(keys, &update.Value)
+		}
+		if !yield(update, nil) {
+			return errStopped
+		}
+	}
+
+	for _, u := range notification.GetUpdate() {
+		path := gnmi.Join(notification.GetPrefix(), u.GetPath())
+		entries, err := gnmi.Entries(path, u.GetVal(), node)
+		if err != nil {
+			return fmt.Errorf("%w at %s: %w", errDecode, gnmi.PathString(path), err)
+		}
+		for _, entry := range entries {
+			update := Update[T]{Path: gnmi.PathString(path), Keys: gnmi.Keys(path), Timestamp: timestamp}
+			if err := json.Unmarshal(entry, &update.Value); err != nil {
+				return fmt.Errorf("%w at %s: %w", errDecode, update.Path, err)
+			}
+			if !yield(update, nil) {
+				return errStopped
+			}
+		}
+	}
+	return nil
+}
+
+// isPermanent reports whether err ends the subscription instead of triggering a reconnection.
+func isPermanent(err error) bool {
+	if errors.Is(err, errDecode) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied,
+		codes.Unauthenticated, codes.Unimplemented, codes.FailedPrecondition:
+		return true
+	default:
+		return false
+	}
+}
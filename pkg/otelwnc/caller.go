@@ -0,0 +1,39 @@
+package otelwnc
+
+import (
+	"runtime"
+	"strings"
+)
+
+// servicePackagePrefix is the import path prefix of the service packages.
+const servicePackagePrefix = "github.com/umatare5/cisco-ios-xe-wireless-go/service/"
+
+// maxCallerDepth bounds the stack walk looking for the calling service method.
+const maxCallerDepth = 32
+
+// callerOperation returns the service method that issued the current call, such as
+// "ap.Service.ListCAPWAPData", or an empty string when the call did not come from a service.
+func callerOperation() string {
+	pcs := make([]uintptr, maxCallerDepth)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, servicePackagePrefix); ok {
+			return cleanFunctionName(name)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// cleanFunctionName turns a runtime function name such as "ap.(*Service).Get[...].func1"
+// into "ap.Service.Get".
+func cleanFunctionName(name string) string {
+	name = strings.NewReplacer("(*", "", ")", "", "[...]", "").Replace(name)
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,11 @@
+// Package otelwnc provides optional OpenTelemetry instrumentation for the WNC client.
+//
+// The instrumentation is a client middleware, so every service package is covered without
+// changes. Each RESTCONF call produces a client span named after its route constant
+// (for example "routes.APCapwapDataPath") and records a latency histogram and an error counter.
+//
+//	client, err := wnc.NewClient(host, token, otelwnc.WithInstrumentation())
+//
+// The global tracer and meter providers are used unless WithTracerProvider or
+// WithMeterProvider are given.
+package otelwnc
@@ -0,0 +1,190 @@
+package otelwnc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+)
+
+// ScopeName is the instrumentation scope name of tracers and meters created by this package.
+const ScopeName = "github.com/umatare5/cisco-ios-xe-wireless-go/pkg/otelwnc"
+
+// Metric names.
+const (
+	// RequestDurationMetric is the histogram of RESTCONF call latency in seconds.
+	RequestDurationMetric = "wnc.client.request.duration"
+	// RequestErrorsMetric counts failed RESTCONF calls.
+	RequestErrorsMetric = "wnc.client.request.errors"
+)
+
+// Attribute keys recorded on spans and metrics.
+const (
+	ServerAddressKey    = attribute.Key("server.address")            // Controller host
+	HTTPMethodKey       = attribute.Key("http.request.method")       // HTTP method
+	HTTPStatusCodeKey   = attribute.Key("http.response.status_code") // HTTP status code
+	HTTPResponseSizeKey = attribute.Key("http.response.body.size")   // Response body size in bytes (spans only)
+	URLPathKey          = attribute.Key("url.path")                  // Request path including keys (spans only)
+	ErrorTypeKey        = attribute.Key("error.type")                // Failure class of failed calls
+	RouteKey            = attribute.Key("wnc.route")                 // Route constant name
+	OperationKey        = attribute.Key("wnc.operation")             // Service method, e.g. "ap.Service.ListCAPWAPData"
+	RPCKey              = attribute.Key("wnc.rpc")                   // True for RESTCONF operations calls
+)
+
+// Span naming.
+const (
+	routeSpanNamePrefix  = "routes."
+	unknownRouteSpanName = "RESTCONF"
+)
+
+// config holds the instrumentation settings.
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider, defaulting to the global provider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		if tp != nil {
+			c.tracerProvider = tp
+		}
+	}
+}
+
+// WithMeterProvider sets the meter provider, defaulting to the global provider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		if mp != nil {
+			c.meterProvider = mp
+		}
+	}
+}
+
+// WithInstrumentation returns a client option that installs the OpenTelemetry middleware.
+func WithInstrumentation(opts ...Option) wnc.Option {
+	mw, err := NewMiddleware(opts...)
+	if err != nil {
+		return func(*core.Client) error { return err }
+	}
+	return wnc.WithMiddleware(mw)
+}
+
+// NewMiddleware creates a client middleware that traces and measures every RESTCONF call.
+func NewMiddleware(opts ...Option) (wnc.Middleware, error) {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(ScopeName)
+	duration, err := meter.Float64Histogram(RequestDurationMetric,
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of RESTCONF calls to the wireless controller."))
+	if err != nil {
+		return nil, fmt.Errorf("instrumentation setup failed: %w", err)
+	}
+	failures, err := meter.Int64Counter(RequestErrorsMetric,
+		metric.WithUnit("{error}"),
+		metric.WithDescription("Number of failed RESTCONF calls to the wireless controller."))
+	if err != nil {
+		return nil, fmt.Errorf("instrumentation setup failed: %w", err)
+	}
+
+	inst := &instrumentation{
+		tracer:   cfg.tracerProvider.Tracer(ScopeName),
+		duration: duration,
+		failures: failures,
+	}
+	return inst.middleware, nil
+}
+
+// instrumentation records spans and metrics for RESTCONF calls.
+type instrumentation struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	failures metric.Int64Counter
+}
+
+// middleware wraps next with a span and metric recording.
+func (i *instrumentation) middleware(next wnc.Doer) wnc.Doer {
+	return wnc.DoerFunc(func(ctx context.Context, req *wnc.Request) (*wnc.Response, error) {
+		route := routes.Name(req.Path)
+		attrs := []attribute.KeyValue{
+			ServerAddressKey.String(req.Host),
+			HTTPMethodKey.String(req.Method),
+			RPCKey.Bool(req.RPC),
+		}
+		if route != "" {
+			attrs = append(attrs, RouteKey.String(route))
+		}
+		if operation := callerOperation(); operation != "" {
+			attrs = append(attrs, OperationKey.String(operation))
+		}
+
+		ctx, span := i.tracer.Start(ctx, spanName(route),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+			trace.WithAttributes(URLPathKey.String(req.Path)))
+		defer span.End()
+
+		start := time.Now()
+		resp, err := next.Do(ctx, req)
+		elapsed := time.Since(start)
+
+		if resp != nil {
+			attrs = append(attrs, HTTPStatusCodeKey.Int(resp.StatusCode))
+			span.SetAttributes(HTTPStatusCodeKey.Int(resp.StatusCode), HTTPResponseSizeKey.Int(len(resp.Body)))
+		}
+		if err != nil {
+			attrs = append(attrs, ErrorTypeKey.String(errorType(err)))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			i.failures.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		i.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+		return resp, err
+	})
+}
+
+// spanName returns the span name for a route constant name.
+func spanName(route string) string {
+	if route == "" {
+		return unknownRouteSpanName
+	}
+	return routeSpanNamePrefix + route
+}
+
+// errorType classifies err with low cardinality: the HTTP status code for API errors,
+// otherwise the Go error type.
+func errorType(err error) string {
+	var apiErr *wnc.APIError
+	if errors.As(err, &apiErr) {
+		return strconv.Itoa(apiErr.StatusCode)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	if errors.Is(err, context.Canceled) {
+		return "canceled"
+	}
+	return fmt.Sprintf("%T", err)
+}
@@ -0,0 +1,185 @@
+package otelwnc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+const testToken = "YWRtaW46cGFzc3dvcmQ="
+
+// newInstrumentedClient starts a TLS server with handler and returns an instrumented client
+// together with the span recorder and metric reader.
+func newInstrumentedClient(
+	t *testing.T, handler http.HandlerFunc,
+) (*wnc.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader, string) {
+	t.Helper()
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	client, err := wnc.NewClient(host, testToken,
+		wnc.WithInsecureSkipVerify(true),
+		WithInstrumentation(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+			WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client, recorder, reader, host
+}
+
+// spanAttr returns the value of key on the attribute set.
+func spanAttr(attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+// collectMetric returns the named metric from reader.
+func collectMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) (metricdata.Metrics, bool) {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("failed to collect metrics: %v", err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m, true
+			}
+		}
+	}
+	return metricdata.Metrics{}, false
+}
+
+func TestOtelWNCUnit_Middleware_SuccessSpanAndMetrics(t *testing.T) {
+	body := `{"Cisco-IOS-XE-wireless-access-point-oper:capwap-data":[]}`
+	client, recorder, reader, host := newInstrumentedClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})
+
+	if _, err := client.AP().ListCAPWAPData(context.Background()); err != nil {
+		t.Fatalf("ListCAPWAPData failed: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	testutil.AssertStringEquals(t, span.Name(), "routes.APCapwapDataPath", "span name")
+
+	attrs := span.Attributes()
+	expected := map[attribute.Key]string{
+		ServerAddressKey: host,
+		HTTPMethodKey:    http.MethodGet,
+		RouteKey:         "APCapwapDataPath",
+		OperationKey:     "ap.Service.ListCAPWAPData",
+	}
+	for key, want := range expected {
+		got, ok := spanAttr(attrs, key)
+		if !ok {
+			t.Errorf("span attribute %s missing", key)
+			continue
+		}
+		testutil.AssertStringEquals(t, got.AsString(), want, string(key))
+	}
+	if status, _ := spanAttr(attrs, HTTPStatusCodeKey); status.AsInt64() != http.StatusOK {
+		t.Errorf("expected status attribute 200, got %v", status.AsInt64())
+	}
+	if size, _ := spanAttr(attrs, HTTPResponseSizeKey); size.AsInt64() != int64(len(body)) {
+		t.Errorf("expected size attribute %d, got %v", len(body), size.AsInt64())
+	}
+
+	duration, ok := collectMetric(t, reader, RequestDurationMetric)
+	if !ok {
+		t.Fatalf("metric %s not recorded", RequestDurationMetric)
+	}
+	hist, ok := duration.Data.(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 1 || hist.DataPoints[0].Count != 1 {
+		t.Errorf("expected one histogram observation, got %+v", duration.Data)
+	}
+	if _, ok := collectMetric(t, reader, RequestErrorsMetric); ok {
+		t.Errorf("metric %s must not be recorded for successful calls", RequestErrorsMetric)
+	}
+}
+
+func TestOtelWNCUnit_Middleware_ErrorSpanAndCounter(t *testing.T) {
+	client, recorder, reader, _ := newInstrumentedClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	_, err := client.AP().GetCAPWAPDataByWTPMAC(context.Background(), "00:11:22:33:44:55")
+	var apiErr *wnc.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	testutil.AssertStringEquals(t, spans[0].Name(), "routes.APCapwapDataPath", "span name")
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("expected error span status, got %v", spans[0].Status().Code)
+	}
+	if op, _ := spanAttr(spans[0].Attributes(), OperationKey); op.AsString() != "ap.Service.GetCAPWAPDataByWTPMAC" {
+		t.Errorf("unexpected operation attribute %q", op.AsString())
+	}
+
+	failures, ok := collectMetric(t, reader, RequestErrorsMetric)
+	if !ok {
+		t.Fatalf("metric %s not recorded", RequestErrorsMetric)
+	}
+	sum, ok := failures.Data.(metricdata.Sum[int64])
+	if !ok || len(sum.DataPoints) != 1 || sum.DataPoints[0].Value != 1 {
+		t.Fatalf("expected one error, got %+v", failures.Data)
+	}
+	errType, _ := sum.DataPoints[0].Attributes.Value(ErrorTypeKey)
+	testutil.AssertStringEquals(t, errType.AsString(), "404", "error.type")
+}
+
+func TestOtelWNCUnit_spanName(t *testing.T) {
+	testutil.AssertStringEquals(t, spanName("APOperPath"), "routes.APOperPath", "known route")
+	testutil.AssertStringEquals(t, spanName(""), "RESTCONF", "unknown route")
+}
+
+func TestOtelWNCUnit_cleanFunctionName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"value receiver", "ap.Service.ListCAPWAPData", "ap.Service.ListCAPWAPData"},
+		{"pointer receiver", "site.(*Service).ListSiteTagConfigs", "site.Service.ListSiteTagConfigs"},
+		{"closure", "wlan.Service.GetConfig.func1", "wlan.Service.GetConfig"},
+		{"generic", "rogue.list[...].func2", "rogue.list"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.AssertStringEquals(t, cleanFunctionName(tt.input), tt.expected, "cleanFunctionName()")
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Package telemetry receives model-driven telemetry that controllers send to a collector
+// (dial-out) and decodes it into the existing service structs.
+//
+// The Receiver serves the IOS-XE gRPC dial-out service for subscriptions configured with
+// "encoding encode-kvgpb" and "receiver ip address <collector> 57500 protocol grpc-tcp".
+// Encoding paths are mapped onto the RESTCONF routes, and each row is delivered on the channels
+// subscribed to its route:
+//
+//	receiver, err := telemetry.NewReceiver()
+//	clients, err := telemetry.SubscribeClients(receiver)
+//	go receiver.ListenAndServe(":57500")
+//	defer receiver.Close()
+//	for update := range clients {
+//		fmt.Println(update.NodeID, update.Value.ClientMAC)
+//	}
+//
+// Compact GPB encoding is not supported, since it requires the model-specific protobuf schema.
+package telemetry
@@ -0,0 +1,222 @@
+package telemetry
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/netconf"
+)
+
+// Field numbers of the IOS-XE dial-out messages (mdt_grpc_dialout.proto and telemetry.proto).
+const (
+	// MdtDialoutArgs
+	argsData   protowire.Number = 2
+	argsErrors protowire.Number = 3
+
+	// Telemetry
+	telemetryNodeID         protowire.Number = 1
+	telemetrySubscriptionID protowire.Number = 3
+	telemetryEncodingPath   protowire.Number = 6
+	telemetryMsgTimestamp   protowire.Number = 10
+	telemetryDataGPBKV      protowire.Number = 11
+	telemetryDataGPB        protowire.Number = 12
+
+	// TelemetryField
+	fieldTimestamp   protowire.Number = 1
+	fieldName        protowire.Number = 2
+	fieldBytesValue  protowire.Number = 4
+	fieldStringValue protowire.Number = 5
+	fieldBoolValue   protowire.Number = 6
+	fieldUint32Value protowire.Number = 7
+	fieldUint64Value protowire.Number = 8
+	fieldSint32Value protowire.Number = 9
+	fieldSint64Value protowire.Number = 10
+	fieldDoubleValue protowire.Number = 11
+	fieldFloatValue  protowire.Number = 12
+	fieldFields      protowire.Number = 15
+)
+
+// Names of the row members holding the list keys and the data of a kvGPB row.
+const (
+	rowKeys    = "keys"
+	rowContent = "content"
+)
+
+// dialoutArgs is a message of the MdtDialout stream.
+type dialoutArgs struct {
+	data   []byte // Encoded Telemetry message
+	errors string // Error reported by the controller
+}
+
+// message is a decoded Telemetry message.
+type message struct {
+	nodeID         string
+	subscriptionID string
+	encodingPath   string
+	timestamp      uint64  // Milliseconds since the Unix epoch
+	rows           []field // Self-describing kvGPB rows
+	compact        bool    // Data is compact GPB, which needs the model-specific schema
+}
+
+// field is a decoded TelemetryField, either a named scalar or a container of fields.
+type field struct {
+	timestamp uint64
+	name      string
+	value     string // Scalar rendered as in the XML encoding
+	fields    []field
+}
+
+// child returns the first member field with the given name, or nil.
+func (f *field) child(name string) *field {
+	for i := range f.fields {
+		if f.fields[i].name == name {
+			return &f.fields[i]
+		}
+	}
+	return nil
+}
+
+// errMalformed marks messages that do not follow the dial-out protobuf schema.
+var errMalformed = errors.New("malformed protobuf message")
+
+// visit calls fn for every field of the protobuf message b, passing the raw varint or
+// length-delimited value and the wire type.
+func visit(b []byte, fn func(num protowire.Number, typ protowire.Type, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return fmt.Errorf("%w: %w", errMalformed, protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		var v uint64
+		var data []byte
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v32 uint32
+			v32, n = protowire.ConsumeFixed32(b)
+			v = uint64(v32)
+		case protowire.Fixed64Type:
+			v, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			data, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return fmt.Errorf("%w: %w", errMalformed, protowire.ParseError(n))
+		}
+		b = b[n:]
+		if err := fn(num, typ, v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeArgs decodes an MdtDialoutArgs message.
+func decodeArgs(b []byte) (dialoutArgs, error) {
+	var args dialoutArgs
+	err := visit(b, func(num protowire.Number, typ protowire.Type, _ uint64, data []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case argsData:
+			args.data = data
+		case argsErrors:
+			args.errors = string(data)
+		}
+		return nil
+	})
+	return args, err
+}
+
+// decodeMessage decodes a Telemetry message.
+func decodeMessage(b []byte) (message, error) {
+	var msg message
+	err := visit(b, func(num protowire.Number, typ protowire.Type, v uint64, data []byte) error {
+		switch num {
+		case telemetryNodeID:
+			msg.nodeID = string(data)
+		case telemetrySubscriptionID:
+			msg.subscriptionID = string(data)
+		case telemetryEncodingPath:
+			msg.encodingPath = string(data)
+		case telemetryMsgTimestamp:
+			msg.timestamp = v
+		case telemetryDataGPBKV:
+			row, err := decodeField(data)
+			if err != nil {
+				return err
+			}
+			msg.rows = append(msg.rows, row)
+		case telemetryDataGPB:
+			msg.compact = typ == protowire.BytesType
+		}
+		return nil
+	})
+	return msg, err
+}
+
+// decodeField decodes a TelemetryField message with its nested fields.
+func decodeField(b []byte) (field, error) {
+	var f field
+	err := visit(b, func(num protowire.Number, _ protowire.Type, v uint64, data []byte) error {
+		switch num {
+		case fieldTimestamp:
+			f.timestamp = v
+		case fieldName:
+			f.name = string(data)
+		case fieldBytesValue:
+			f.value = base64.StdEncoding.EncodeToString(data)
+		case fieldStringValue:
+			f.value = string(data)
+		case fieldBoolValue:
+			f.value = strconv.FormatBool(v != 0)
+		case fieldUint32Value, fieldUint64Value:
+			f.value = strconv.FormatUint(v, 10)
+		case fieldSint32Value, fieldSint64Value:
+			f.value = strconv.FormatInt(protowire.DecodeZigZag(v), 10)
+		case fieldDoubleValue:
+			f.value = strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64)
+		case fieldFloatValue:
+			f.value = strconv.FormatFloat(float64(math.Float32frombits(uint32(v))), 'g', -1, 32)
+		case fieldFields:
+			child, err := decodeField(data)
+			if err != nil {
+				return err
+			}
+			f.fields = append(f.fields, child)
+		}
+		return nil
+	})
+	return f, err
+}
+
+// nodes converts fields into elements, so that netconf.ToJSON can render them with the Go
+// type as the schema, the same way as NETCONF replies.
+func nodes(fields []field) []*netconf.Node {
+	out := make([]*netconf.Node, 0, len(fields))
+	for _, f := range fields {
+		name := f.name
+		if _, local, ok := strings.Cut(name, ":"); ok {
+			name = local
+		}
+		out = append(out, &netconf.Node{
+			Name:     xml.Name{Local: name},
+			Text:     f.value,
+			Children: nodes(f.fields),
+		})
+	}
+	return out
+}
@@ -0,0 +1,267 @@
+package telemetry
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Receiver default values.
+const (
+	// DefaultPort is the collector port the receiver listens on when no address is given.
+	DefaultPort = 57500
+	// DefaultBufferSize is the capacity of subscription channels.
+	DefaultBufferSize = 64
+)
+
+// gRPC service and method IOS-XE calls for dial-out telemetry.
+const (
+	dialoutService = "mdt_dialout.gRPCMdtDialout"
+	dialoutMethod  = "MdtDialout"
+)
+
+// config holds the receiver settings.
+type config struct {
+	tlsConfig     *tls.Config
+	bufferSize    int
+	logger        *slog.Logger
+	serverOptions []grpc.ServerOption
+}
+
+// Option configures a Receiver.
+type Option func(*config) error
+
+// WithTLSConfig serves TLS with the given configuration, for receivers configured with
+// "protocol grpc-tls" on the controller. Plaintext ("protocol grpc-tcp") is served by default.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *config) error {
+		if tlsConfig == nil {
+			return errors.New("TLS configuration cannot be nil")
+		}
+		c.tlsConfig = tlsConfig
+		return nil
+	}
+}
+
+// WithBufferSize sets the capacity of subscription channels, DefaultBufferSize by default.
+// A full channel holds back the stream of the controller that sends to it.
+func WithBufferSize(size int) Option {
+	return func(c *config) error {
+		if size < 0 {
+			return fmt.Errorf("buffer size validation failed: invalid size %d", size)
+		}
+		c.bufferSize = size
+		return nil
+	}
+}
+
+// WithLogger sets the logger reporting dropped messages, slog.Default() by default.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) error {
+		if logger == nil {
+			return errors.New("logger cannot be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithServerOptions appends gRPC server options, for example keepalive parameters.
+func WithServerOptions(opts ...grpc.ServerOption) Option {
+	return func(c *config) error {
+		c.serverOptions = append(c.serverOptions, opts...)
+		return nil
+	}
+}
+
+// Receiver accepts dial-out telemetry streams from controllers and delivers the decoded
+// updates to subscription channels.
+type Receiver struct {
+	cfg    config
+	server *grpc.Server
+
+	mu     sync.Mutex
+	sinks  map[string][]sink // Subscriptions by encoding path
+	closed bool
+	done   chan struct{}
+}
+
+// NewReceiver creates a receiver. Subscriptions should be added before serving, since
+// updates for encoding paths without subscribers are discarded.
+func NewReceiver(opts ...Option) (*Receiver, error) {
+	cfg := config{bufferSize: DefaultBufferSize, logger: slog.Default()}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, fmt.Errorf("receiver initialization failed: %w", err)
+		}
+	}
+
+	r := &Receiver{cfg: cfg, sinks: map[string][]sink{}, done: make(chan struct{})}
+	serverOptions := []grpc.ServerOption{grpc.ForceServerCodec(frameCodec{}), grpc.WaitForHandlers(true)}
+	if cfg.tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(cfg.tlsConfig)))
+	}
+	r.server = grpc.NewServer(append(serverOptions, cfg.serverOptions...)...)
+	r.server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: dialoutService,
+		HandlerType: (*dialoutServer)(nil),
+		Streams: []grpc.StreamDesc{{
+			StreamName: dialoutMethod,
+			Handler: func(srv any, stream grpc.ServerStream) error {
+				return srv.(dialoutServer).mdtDialout(stream)
+			},
+			ServerStreams: true,
+			ClientStreams: true,
+		}},
+		Metadata: "mdt_grpc_dialout.proto",
+	}, r)
+	return r, nil
+}
+
+// Serve accepts controller connections on listener until Close is called.
+func (r *Receiver) Serve(listener net.Listener) error {
+	return r.server.Serve(listener)
+}
+
+// ListenAndServe listens on the TCP address, ":57500" when empty, and calls Serve.
+func (r *Receiver) ListenAndServe(address string) error {
+	if address == "" {
+		address = ":" + strconv.Itoa(DefaultPort)
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("receiver listen failed: %w", err)
+	}
+	return r.Serve(listener)
+}
+
+// Close stops the receiver, ending all controller streams, and closes subscription channels.
+func (r *Receiver) Close() error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	close(r.done)
+	r.mu.Unlock()
+
+	// Stop waits for the stream handlers, so no update is sent on a closed channel
+	r.server.Stop()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, sinks := range r.sinks {
+		for _, s := range sinks {
+			s.close()
+		}
+	}
+	return nil
+}
+
+// dialoutServer is the handler type of the dial-out service.
+type dialoutServer interface {
+	mdtDialout(stream grpc.ServerStream) error
+}
+
+// mdtDialout receives the messages of one controller stream.
+func (r *Receiver) mdtDialout(stream grpc.ServerStream) error {
+	addr := ""
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		addr = p.Addr.String()
+	}
+	for {
+		var frame frame
+		if err := stream.RecvMsg(&frame); err != nil {
+			return err
+		}
+		args, err := decodeArgs(frame)
+		if err != nil {
+			r.cfg.logger.Warn("telemetry message dropped", "peer", addr, "error", err)
+			continue
+		}
+		if args.errors != "" {
+			r.cfg.logger.Warn("controller reported telemetry error", "peer", addr, "error", args.errors)
+		}
+		if len(args.data) == 0 {
+			continue
+		}
+		msg, err := decodeMessage(args.data)
+		if err != nil {
+			r.cfg.logger.Warn("telemetry message dropped", "peer", addr, "error", err)
+			continue
+		}
+		if !r.dispatch(stream, msg) {
+			return nil
+		}
+	}
+}
+
+// dispatch delivers a message to the subscriptions of its encoding path. It reports false
+// when the stream or the receiver ended while waiting for a full channel.
+func (r *Receiver) dispatch(stream grpc.ServerStream, msg message) bool {
+	path := normalizePath(msg.encodingPath)
+	r.mu.Lock()
+	sinks := r.sinks[path]
+	r.mu.Unlock()
+	if len(sinks) == 0 {
+		r.cfg.logger.Debug("telemetry message without subscription", "encoding_path", msg.encodingPath)
+		return true
+	}
+	if msg.compact {
+		r.cfg.logger.Warn("telemetry message dropped, configure encode-kvgpb encoding",
+			"encoding_path", msg.encodingPath)
+		return true
+	}
+
+	for _, row := range msg.rows {
+		for _, s := range sinks {
+			err := s.deliver(stream.Context().Done(), r.done, msg, row)
+			switch {
+			case errors.Is(err, errEnded):
+				return false
+			case err != nil:
+				r.cfg.logger.Warn("telemetry update dropped", "encoding_path", msg.encodingPath, "error", err)
+			}
+		}
+	}
+	return true
+}
+
+// frame is a raw gRPC message, decoded by the receiver itself.
+type frame []byte
+
+// frameCodec passes gRPC messages through as frames, since the dial-out messages are decoded
+// without generated protobuf code.
+type frameCodec struct{}
+
+// Marshal returns the bytes of a frame.
+func (frameCodec) Marshal(v any) ([]byte, error) {
+	f, ok := v.(*frame)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return *f, nil
+}
+
+// Unmarshal stores data in a frame.
+func (frameCodec) Unmarshal(data []byte, v any) error {
+	f, ok := v.(*frame)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	*f = append((*f)[:0], data...)
+	return nil
+}
+
+// Name returns the content subtype served by the codec.
+func (frameCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,159 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/gnmi"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/netconf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/ap"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/client"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/rogue"
+)
+
+// Routes of the operational lists with typed subscription helpers.
+const (
+	// RouteClientCommonOperData is the client common operational data list.
+	RouteClientCommonOperData = routes.ClientCommonOperDataPath
+	// RouteAPCapwapData is the AP CAPWAP data list.
+	RouteAPCapwapData = routes.APCapwapDataPath
+	// RouteRogueData is the rogue data list.
+	RouteRogueData = routes.RogueDataPath
+)
+
+// Update is a telemetry update of one list entry or container.
+type Update[T any] struct {
+	Route          string            // Route matching the encoding path, e.g. RouteClientCommonOperData
+	EncodingPath   string            // Encoding path sent by the controller
+	NodeID         string            // Hostname of the sending controller
+	SubscriptionID string            // Telemetry subscription ID configured on the controller
+	Keys           map[string]string // List keys of the entry
+	Timestamp      time.Time         // Time the controller collected the entry
+	Value          T                 // Decoded entry
+}
+
+// errEnded signals that the stream or the receiver ended while an update was pending.
+var errEnded = errors.New("delivery ended")
+
+// sink delivers the rows of an encoding path to one subscription.
+type sink interface {
+	deliver(streamDone, receiverDone <-chan struct{}, msg message, row field) error
+	close()
+}
+
+// channelSink decodes rows into T and sends them on a channel.
+type channelSink[T any] struct {
+	route string
+	ch    chan Update[T]
+}
+
+// deliver decodes row and sends it, waiting while the channel is full.
+func (s *channelSink[T]) deliver(streamDone, receiverDone <-chan struct{}, msg message, row field) error {
+	var fields []field
+	update := Update[T]{
+		Route:          s.route,
+		EncodingPath:   msg.encodingPath,
+		NodeID:         msg.nodeID,
+		SubscriptionID: msg.subscriptionID,
+		Timestamp:      time.UnixMilli(int64(max(row.timestamp, msg.timestamp))),
+	}
+	if keys := row.child(rowKeys); keys != nil {
+		fields = append(fields, keys.fields...)
+		update.Keys = make(map[string]string, len(keys.fields))
+		for _, key := range keys.fields {
+			update.Keys[key.name] = key.value
+		}
+	}
+	if content := row.child(rowContent); content != nil {
+		fields = append(fields, content.fields...)
+	}
+
+	document, err := netconf.ToJSON(nodes(fields), reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(document, &update.Value); err != nil {
+		return fmt.Errorf("failed to decode update: %w", err)
+	}
+
+	select {
+	case s.ch <- update:
+		return nil
+	case <-streamDone:
+		return errEnded
+	case <-receiverDone:
+		return errEnded
+	}
+}
+
+// close closes the channel.
+func (s *channelSink[T]) close() {
+	close(s.ch)
+}
+
+// Subscribe returns a channel of the updates received for the data at route, such as
+// RouteClientCommonOperData, decoded into T, the type of one list entry or of the container at
+// route. The controller subscription must use the matching xpath filter and encode-kvgpb
+// encoding. The channel is closed by Receiver.Close.
+func Subscribe[T any](r *Receiver, route string) (<-chan Update[T], error) {
+	if r == nil {
+		return nil, errors.New("receiver cannot be nil")
+	}
+	path, err := gnmi.PathFromRoute(route)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &channelSink[T]{route: route, ch: make(chan Update[T], r.cfg.bufferSize)}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, errors.New("receiver is closed")
+	}
+	encodingPath := normalizePath(gnmi.PathString(path))
+	r.sinks[encodingPath] = append(r.sinks[encodingPath], s)
+	return s.ch, nil
+}
+
+// SubscribeClients returns a channel of client common operational data updates.
+func SubscribeClients(r *Receiver) (<-chan Update[client.CommonOperData], error) {
+	return Subscribe[client.CommonOperData](r, RouteClientCommonOperData)
+}
+
+// SubscribeCAPWAPData returns a channel of AP CAPWAP data updates.
+func SubscribeCAPWAPData(r *Receiver) (<-chan Update[ap.CAPWAPData], error) {
+	return Subscribe[ap.CAPWAPData](r, RouteAPCapwapData)
+}
+
+// SubscribeRogues returns a channel of rogue data updates.
+func SubscribeRogues(r *Receiver) (<-chan Update[rogue.RogueData], error) {
+	return Subscribe[rogue.RogueData](r, RouteRogueData)
+}
+
+// RouteOf returns the route of an encoding path such as
+// "Cisco-IOS-XE-wireless-client-oper:client-oper-data/common-oper-data".
+func RouteOf(encodingPath string) string {
+	return routes.RESTCONFDataPath + "/" + normalizePath(encodingPath)
+}
+
+// normalizePath returns an encoding path without leading slash and list predicates.
+func normalizePath(encodingPath string) string {
+	var sb strings.Builder
+	depth := 0
+	for _, c := range strings.TrimPrefix(encodingPath, "/") {
+		switch {
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,214 @@
+package telemetry
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// kv encodes a TelemetryField with a scalar value or nested fields.
+func kv(name string, value any, fields ...[]byte) []byte {
+	var b []byte
+	b = protowire.AppendTag(b, fieldName, protowire.BytesType)
+	b = protowire.AppendString(b, name)
+	switch v := value.(type) {
+	case string:
+		b = protowire.AppendTag(b, fieldStringValue, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	case uint32:
+		b = protowire.AppendTag(b, fieldUint32Value, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	case int64:
+		b = protowire.AppendTag(b, fieldSint64Value, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(v))
+	case bool:
+		b = protowire.AppendTag(b, fieldBoolValue, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+	}
+	for _, f := range fields {
+		b = protowire.AppendTag(b, fieldFields, protowire.BytesType)
+		b = protowire.AppendBytes(b, f)
+	}
+	return b
+}
+
+// row encodes a kvGPB row with its keys and content.
+func row(timestamp uint64, keys, content [][]byte) []byte {
+	b := protowire.AppendTag(nil, fieldTimestamp, protowire.VarintType)
+	b = protowire.AppendVarint(b, timestamp)
+	for _, f := range [][]byte{kv(rowKeys, nil, keys...), kv(rowContent, nil, content...)} {
+		b = protowire.AppendTag(b, fieldFields, protowire.BytesType)
+		b = protowire.AppendBytes(b, f)
+	}
+	return b
+}
+
+// dialoutMessage encodes an MdtDialoutArgs message carrying a Telemetry message.
+func dialoutMessage(encodingPath string, rows ...[]byte) *frame {
+	var msg []byte
+	msg = protowire.AppendTag(msg, telemetryNodeID, protowire.BytesType)
+	msg = protowire.AppendString(msg, "wnc1")
+	msg = protowire.AppendTag(msg, telemetrySubscriptionID, protowire.BytesType)
+	msg = protowire.AppendString(msg, "101")
+	msg = protowire.AppendTag(msg, telemetryEncodingPath, protowire.BytesType)
+	msg = protowire.AppendString(msg, encodingPath)
+	for _, r := range rows {
+		msg = protowire.AppendTag(msg, telemetryDataGPBKV, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, r)
+	}
+	args := protowire.AppendTag(nil, argsData, protowire.BytesType)
+	args = protowire.AppendBytes(args, msg)
+	f := frame(args)
+	return &f
+}
+
+// startReceiver serves receiver on a local port and returns a function sending dial-out messages
+// on one stream, as a controller does.
+func startReceiver(t *testing.T, receiver *Receiver) func(msg *frame) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.AssertNoError(t, err, "listen")
+	go func() { _ = receiver.Serve(listener) }()
+	t.Cleanup(func() { _ = receiver.Close() })
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	testutil.AssertNoError(t, err, "dial")
+	t.Cleanup(func() { _ = conn.Close() })
+	stream, err := conn.NewStream(context.Background(),
+		&grpc.StreamDesc{ServerStreams: true, ClientStreams: true},
+		"/"+dialoutService+"/"+dialoutMethod, grpc.ForceCodec(frameCodec{}))
+	testutil.AssertNoError(t, err, "open stream")
+	return func(msg *frame) {
+		t.Helper()
+		testutil.AssertNoError(t, stream.SendMsg(msg), "send")
+	}
+}
+
+// newTestReceiver returns a receiver with a discarding logger.
+func newTestReceiver(t *testing.T) *Receiver {
+	t.Helper()
+	receiver, err := NewReceiver(WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	testutil.AssertNoError(t, err, "NewReceiver")
+	return receiver
+}
+
+// receive returns the next update of ch, failing the test after a timeout.
+func receive[T any](t *testing.T, ch <-chan Update[T]) Update[T] {
+	t.Helper()
+	select {
+	case update := <-ch:
+		return update
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for update")
+		return Update[T]{}
+	}
+}
+
+func TestTelemetryUnit_NewReceiver_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"NilTLSConfig", WithTLSConfig(nil)},
+		{"NegativeBufferSize", WithBufferSize(-1)},
+		{"NilLogger", WithLogger(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReceiver(tt.opt)
+			testutil.AssertError(t, err, tt.name)
+		})
+	}
+
+	receiver := newTestReceiver(t)
+	_, err := Subscribe[struct{}](nil, RouteClientCommonOperData)
+	testutil.AssertError(t, err, "nil receiver")
+	_, err = Subscribe[struct{}](receiver, RouteAPCapwapData+"=00:11:22:33:44:55")
+	testutil.AssertErrorContains(t, err, "list keys are not supported", "keyed route")
+
+	clients, err := SubscribeClients(receiver)
+	testutil.AssertNoError(t, err, "SubscribeClients")
+	testutil.AssertNoError(t, receiver.Close(), "Close")
+	_, open := <-clients
+	testutil.AssertFalse(t, open, "channel closed by Close")
+	_, err = SubscribeRogues(receiver)
+	testutil.AssertErrorContains(t, err, "closed", "subscribe after Close")
+	testutil.AssertNoError(t, receiver.Close(), "second Close")
+}
+
+func TestTelemetryUnit_Receiver_KVGPB(t *testing.T) {
+	receiver := newTestReceiver(t)
+	clients, err := SubscribeClients(receiver)
+	testutil.AssertNoError(t, err, "SubscribeClients")
+	capwap, err := SubscribeCAPWAPData(receiver)
+	testutil.AssertNoError(t, err, "SubscribeCAPWAPData")
+	send := startReceiver(t, receiver)
+
+	send(dialoutMessage("Cisco-IOS-XE-wireless-rogue-oper:rogue-oper-data/rogue-data",
+		row(1, [][]byte{kv("rogue-address", "aa")}, nil)))
+	send(dialoutMessage("Cisco-IOS-XE-wireless-client-oper:client-oper-data/common-oper-data",
+		row(1767323045000, [][]byte{kv("client-mac", "aa:bb:cc:dd:ee:01")},
+			[][]byte{kv("ap-name", "ap1"), kv("ms-ap-slot-id", uint32(1)), kv("username", "alice")}),
+		row(1767323045000, [][]byte{kv("client-mac", "aa:bb:cc:dd:ee:02")}, [][]byte{kv("ap-name", "ap2")})))
+	send(dialoutMessage("/Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data/capwap-data",
+		row(2, [][]byte{kv("wtp-mac", "00:11:22:33:44:55")}, [][]byte{kv("name", "ap1")})))
+
+	first := receive(t, clients)
+	testutil.AssertStringEquals(t, first.Value.ClientMAC, "aa:bb:cc:dd:ee:01", "key decoded")
+	testutil.AssertStringEquals(t, first.Value.ApName, "ap1", "string leaf")
+	testutil.AssertIntEquals(t, first.Value.MsApSlotID, 1, "number leaf")
+	testutil.AssertStringEquals(t, first.Keys["client-mac"], "aa:bb:cc:dd:ee:01", "keys")
+	testutil.AssertStringEquals(t, first.Route, RouteClientCommonOperData, "route")
+	testutil.AssertStringEquals(t, first.NodeID, "wnc1", "node ID")
+	testutil.AssertStringEquals(t, first.SubscriptionID, "101", "subscription ID")
+	testutil.AssertIntEquals(t, first.Timestamp.UTC().Year(), 2026, "timestamp")
+	second := receive(t, clients)
+	testutil.AssertStringEquals(t, second.Value.ApName, "ap2", "second row")
+
+	ap := receive(t, capwap)
+	testutil.AssertStringEquals(t, ap.Value.WtpMAC, "00:11:22:33:44:55", "CAPWAP key")
+	testutil.AssertStringEquals(t, ap.Value.Name, "ap1", "CAPWAP leaf")
+}
+
+func TestTelemetryUnit_Receiver_NestedData(t *testing.T) {
+	type entry struct {
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+		Offset  int    `json:"offset"`
+		Slots   []struct {
+			ID int `json:"slot-id"`
+		} `json:"radio-slot"`
+	}
+	receiver := newTestReceiver(t)
+	entries, err := Subscribe[entry](receiver, RouteAPCapwapData)
+	testutil.AssertNoError(t, err, "Subscribe")
+	send := startReceiver(t, receiver)
+
+	send(dialoutMessage("Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data/capwap-data",
+		row(1, [][]byte{kv("name", "ap1")}, [][]byte{
+			kv("enabled", true),
+			kv("offset", int64(-3)),
+			kv("radio-slot", nil, kv("slot-id", uint32(0))),
+		})))
+	got := receive(t, entries)
+	testutil.AssertTrue(t, got.Value.Enabled, "boolean leaf")
+	testutil.AssertIntEquals(t, got.Value.Offset, -3, "signed leaf")
+	testutil.AssertIntEquals(t, len(got.Value.Slots), 1, "single list entry decoded as list")
+}
+
+func TestTelemetryUnit_RouteOf(t *testing.T) {
+	testutil.AssertStringEquals(t,
+		RouteOf("/Cisco-IOS-XE-wireless-client-oper:client-oper-data/common-oper-data[client-mac='a']"),
+		RouteClientCommonOperData, "predicates removed")
+	testutil.AssertStringEquals(t,
+		RouteOf("Cisco-IOS-XE-wireless-rogue-oper:rogue-oper-data/rogue-data"), RouteRogueData, "route")
+}
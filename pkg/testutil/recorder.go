@@ -0,0 +1,153 @@
+package testutil
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordedRequest represents a request received by a mock server.
+type RecordedRequest struct {
+	Method      string
+	Path        string      // Decoded request path
+	EscapedPath string      // Request path as sent on the wire
+	Header      http.Header // Request headers
+	Body        string
+}
+
+// RequestRecorder records the requests received by a mock server in arrival order.
+// The zero value is ready to use.
+type RequestRecorder struct {
+	mu       sync.Mutex
+	requests []RecordedRequest
+}
+
+// Requests returns all recorded requests.
+func (r *RequestRecorder) Requests() []RecordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedRequest(nil), r.requests...)
+}
+
+// Writes returns the recorded requests other than GET.
+func (r *RequestRecorder) Writes() []RecordedRequest {
+	var writes []RecordedRequest
+	for _, req := range r.Requests() {
+		if req.Method != http.MethodGet {
+			writes = append(writes, req)
+		}
+	}
+	return writes
+}
+
+// record appends a request to the recorder.
+func (r *RequestRecorder) record(req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, RecordedRequest{
+		Method:      req.Method,
+		Path:        req.URL.Path,
+		EscapedPath: req.URL.EscapedPath(),
+		Header:      req.Header.Clone(),
+		Body:        string(body),
+	})
+}
+
+// WithRequestRecorder records every request received by the mock server into recorder.
+// Write requests are answered with 204 No Content unless a custom response is configured for them.
+func WithRequestRecorder(recorder *RequestRecorder) MockServerOption {
+	return func(cfg *mockServerConfig) {
+		cfg.recorder = recorder
+	}
+}
+
+// WithEntityTags makes the mock server maintain an entity tag that changes on every successful
+// write. GET responses carry it as ETag, and If-Match and If-None-Match headers are evaluated against it.
+func WithEntityTags() MockServerOption {
+	return func(cfg *mockServerConfig) {
+		cfg.entityTags = true
+	}
+}
+
+// WithResponseDelay delays every response of the mock server by delay, or until the request is canceled.
+func WithResponseDelay(delay time.Duration) MockServerOption {
+	return func(cfg *mockServerConfig) {
+		cfg.delay = delay
+	}
+}
+
+// newRecordingMockServer creates a server that serves configured responses by exact path and
+// answers write requests, honoring the recorder, entity tag and delay options.
+func newRecordingMockServer(cfg *mockServerConfig) MockServer {
+	var mu sync.Mutex
+	version := 1
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cfg.recorder != nil {
+			cfg.recorder.record(r)
+		}
+		if cfg.delay > 0 {
+			select {
+			case <-time.After(cfg.delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, "/restconf/data/")
+		path = strings.TrimPrefix(path, "/restconf/operations/")
+
+		custom, hasCustom := cfg.customResponses[path]
+		if hasCustom && custom.Method == "" {
+			custom.Method = http.MethodGet
+		}
+		hasCustom = hasCustom && custom.Method == r.Method
+
+		mu.Lock()
+		etag := fmt.Sprintf(`"v%d"`, version)
+		if cfg.entityTags {
+			if match := r.Header.Get("If-Match"); match != "" && match != etag {
+				mu.Unlock()
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			if r.Method != http.MethodGet && !hasCustom {
+				version++
+			}
+		}
+		mu.Unlock()
+
+		if hasCustom {
+			w.WriteHeader(custom.StatusCode)
+			_, _ = w.Write([]byte(custom.Body))
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if status, ok := cfg.errorPaths[path]; ok {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		body, ok := cfg.successPaths[path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if cfg.entityTags {
+			w.Header().Set("ETag", etag)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		_, _ = w.Write([]byte(body))
+	})
+	return &mockServerImpl{server: httptest.NewTLSServer(handler)}
+}
@@ -0,0 +1,81 @@
+package testutil
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/testutil"
+)
+
+// TestTestUtilUnit_RequestRecorder_Success tests request recording and write responses.
+func TestTestUtilUnit_RequestRecorder_Success(t *testing.T) {
+	t.Parallel()
+
+	recorder := &RequestRecorder{}
+	server := NewMockServer(
+		WithSuccessResponse("test/data", `{"result": "success"}`),
+		WithCustomResponse("test/rejected", ResponseConfig{StatusCode: http.StatusBadRequest, Method: "PATCH"}),
+		WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+	client := NewTestClient(server).Core().(*core.Client)
+	ctx := TestContext(t)
+
+	_, err := core.Get[map[string]any](ctx, client, "test/data")
+	testutil.AssertNoError(t, err, "GET of configured path")
+	testutil.AssertNoError(t, core.PatchVoid(ctx, client, "test/data", map[string]int{"value": 1}), "PATCH")
+	testutil.AssertError(t, core.PatchVoid(ctx, client, "test/rejected", struct{}{}), "custom PATCH response")
+	testutil.AssertNoError(t, core.Delete(ctx, client, "test/data%2F1"), "DELETE")
+
+	testutil.AssertIntEquals(t, len(recorder.Requests()), 4, "recorded requests")
+	writes := recorder.Writes()
+	testutil.AssertIntEquals(t, len(writes), 3, "recorded writes")
+	testutil.AssertStringEquals(t, writes[0].Method, http.MethodPatch, "write method")
+	testutil.AssertStringEquals(t, writes[0].Path, "/restconf/data/test/data", "write path")
+	testutil.AssertStringEquals(t, writes[0].Body, `{"value":1}`, "write body")
+	testutil.AssertStringEquals(t, writes[2].Path, "/restconf/data/test/data/1", "decoded path")
+	testutil.AssertStringEquals(t, writes[2].EscapedPath, "/restconf/data/test/data%2F1", "escaped path")
+}
+
+// TestTestUtilUnit_EntityTags_Success tests entity tag maintenance and conditional requests.
+func TestTestUtilUnit_EntityTags_Success(t *testing.T) {
+	t.Parallel()
+
+	server := NewMockServer(WithSuccessResponse("test/data", `{}`), WithEntityTags())
+	defer server.Close()
+	client := NewTestClient(server).Core().(*core.Client)
+	ctx := TestContext(t)
+
+	_, meta, err := core.GetWithMeta[map[string]any](ctx, client, "test/data", nil)
+	testutil.AssertNoError(t, err, "GET")
+	testutil.AssertStringEquals(t, meta.ETag, `"v1"`, "initial entity tag")
+
+	_, meta, err = core.GetWithMeta[map[string]any](ctx, client, "test/data", []core.Condition{core.IfNoneMatch(`"v1"`)})
+	testutil.AssertNoError(t, err, "conditional GET")
+	testutil.AssertTrue(t, meta.NotModified, "unchanged resource should not be modified")
+
+	testutil.AssertNoError(t, core.PatchVoid(ctx, client, "test/data", struct{}{}, core.IfMatch(`"v1"`)), "PATCH")
+	err = core.PatchVoid(ctx, client, "test/data", struct{}{}, core.IfMatch(`"v1"`))
+	testutil.AssertTrue(t, core.IsPreconditionFailed(err), "stale entity tag should fail the precondition")
+
+	_, meta, err = core.GetWithMeta[map[string]any](ctx, client, "test/data", nil)
+	testutil.AssertNoError(t, err, "GET after write")
+	testutil.AssertStringEquals(t, meta.ETag, `"v2"`, "entity tag after write")
+}
+
+// TestTestUtilUnit_ResponseDelay_Canceled tests that delayed responses end with the request.
+func TestTestUtilUnit_ResponseDelay_Canceled(t *testing.T) {
+	t.Parallel()
+
+	server := NewMockServer(WithSuccessResponse("test/data", `{}`), WithResponseDelay(time.Minute))
+	defer server.Close()
+	client := NewTestClient(server).Core().(*core.Client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := core.Get[map[string]any](ctx, client, "test/data")
+	testutil.AssertError(t, err, "delayed response should exceed the deadline")
+}
@@ -4,6 +4,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
 )
@@ -37,6 +38,9 @@ type mockServerConfig struct {
 	successPaths    map[string]string         // path -> response body (200 OK)
 	errorPaths      map[string]int            // path -> status code
 	customResponses map[string]ResponseConfig // path -> full response config
+	recorder        *RequestRecorder          // records received requests
+	entityTags      bool                      // maintain an entity tag bumped on writes
+	delay           time.Duration             // delay before each response
 }
 
 // testClientImpl implements TestClient interface hiding internal details.
@@ -165,12 +169,21 @@ func NewTestClient(server MockServer) TestClient {
 //	  WithErrorResponse("error-path", 500),
 //	  WithTesting(t),
 //	)
+//
+//	// Recorded write requests
+//	recorder := &RequestRecorder{}
+//	server := NewMockServer(WithSuccessResponses(responses), WithRequestRecorder(recorder))
 func NewMockServer(opts ...MockServerOption) MockServer {
 	cfg := &mockServerConfig{}
 	for _, opt := range opts {
 		opt(cfg)
 	}
 
+	// Recording, entity tags and delays need a server that also answers write requests
+	if cfg.recorder != nil || cfg.entityTags || cfg.delay > 0 {
+		return newRecordingMockServer(cfg)
+	}
+
 	// If we have custom responses or testing context, use the flexible server
 	if len(cfg.customResponses) > 0 || cfg.testing != nil {
 		return newAdvancedMockServer(cfg)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"strconv"
 	"strings"
 
@@ -137,8 +138,9 @@ func (s Service) ListWLANClientStats(
 }
 
 // GetOperational retrieves the complete AP operational data.
-func (s Service) GetOperational(ctx context.Context) (*CiscoIOSXEWirelessAPOper, error) {
-	return core.Get[CiscoIOSXEWirelessAPOper](ctx, s.Client(), routes.APOperPath)
+// Query options such as core.QueryFields or core.QueryDepth reduce the size of the response.
+func (s Service) GetOperational(ctx context.Context, opts ...core.QueryOption) (*CiscoIOSXEWirelessAPOper, error) {
+	return core.Get[CiscoIOSXEWirelessAPOper](ctx, s.Client(), routes.APOperPath, opts...)
 }
 
 // ListApOperData retrieves AP operational data.
@@ -146,9 +148,29 @@ func (s Service) ListApOperData(ctx context.Context) (*CiscoIOSXEWirelessApOperD
 	return core.Get[CiscoIOSXEWirelessApOperData](ctx, s.Client(), routes.APOperDataPath)
 }
 
+// StreamApOperData yields AP operational data entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListApOperData on large controllers.
+func (s Service) StreamApOperData(ctx context.Context, opts ...core.QueryOption) iter.Seq2[OperData, error] {
+	return core.Stream[OperData](ctx, s.Client(), routes.APOperDataPath, opts...)
+}
+
 // ListCAPWAPData retrieves CAPWAP protocol data.
-func (s Service) ListCAPWAPData(ctx context.Context) (*CiscoIOSXEWirelessApOperCAPWAPData, error) {
-	return core.Get[CiscoIOSXEWirelessApOperCAPWAPData](ctx, s.Client(), routes.APCapwapDataPath)
+func (s Service) ListCAPWAPData(
+	ctx context.Context, opts ...core.QueryOption,
+) (*CiscoIOSXEWirelessApOperCAPWAPData, error) {
+	return core.Get[CiscoIOSXEWirelessApOperCAPWAPData](ctx, s.Client(), routes.APCapwapDataPath, opts...)
+}
+
+// ListCAPWAPDataSummary retrieves only the name, WTP MAC and IP address of each joined AP.
+// It is much cheaper than ListCAPWAPData on controllers with many APs.
+func (s Service) ListCAPWAPDataSummary(ctx context.Context) (*CiscoIOSXEWirelessApOperCAPWAPData, error) {
+	return s.ListCAPWAPData(ctx, core.QueryFields("name", "wtp-mac", "ip-addr"))
+}
+
+// StreamCAPWAPData yields CAPWAP data entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListCAPWAPData on large controllers.
+func (s Service) StreamCAPWAPData(ctx context.Context, opts ...core.QueryOption) iter.Seq2[CAPWAPData, error] {
+	return core.Stream[CAPWAPData](ctx, s.Client(), routes.APCapwapDataPath, opts...)
 }
 
 // GetCAPWAPDataByWTPMAC retrieves CAPWAP data for a specific WTP MAC.
@@ -190,6 +212,12 @@ func (s Service) ListRadioData(ctx context.Context) (*CiscoIOSXEWirelessApOperRa
 	return core.Get[CiscoIOSXEWirelessApOperRadioOperData](ctx, s.Client(), routes.APRadioOperDataPath)
 }
 
+// StreamRadioData yields radio operational data entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListRadioData on large controllers.
+func (s Service) StreamRadioData(ctx context.Context, opts ...core.QueryOption) iter.Seq2[RadioOperData, error] {
+	return core.Stream[RadioOperData](ctx, s.Client(), routes.APRadioOperDataPath, opts...)
+}
+
 // GetRadioStatusByWTPMACAndSlot retrieves radio operational data by WTP MAC and slot ID.
 func (s Service) GetRadioStatusByWTPMACAndSlot(
 	ctx context.Context, wtpMAC string, slotID int,
@@ -210,6 +238,12 @@ func (s Service) ListRadioNeighbors(ctx context.Context) (*CiscoIOSXEWirelessApO
 	return core.Get[CiscoIOSXEWirelessApOperApRadioNeighbor](ctx, s.Client(), routes.APRadioNeighborPath)
 }
 
+// StreamRadioNeighbors yields radio neighbor entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListRadioNeighbors on large controllers.
+func (s Service) StreamRadioNeighbors(ctx context.Context, opts ...core.QueryOption) iter.Seq2[ApRadioNeighbor, error] {
+	return core.Stream[ApRadioNeighbor](ctx, s.Client(), routes.APRadioNeighborPath, opts...)
+}
+
 // GetRadioNeighborByAPMACSlotAndBSSID retrieves AP radio neighbor information for a specific AP MAC, slot ID and BSSID.
 // This follows the YANG model key structure: "ap-mac slot-id bssid".
 func (s Service) GetRadioNeighborByAPMACSlotAndBSSID(
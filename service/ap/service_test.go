@@ -698,6 +698,32 @@ func TestApServiceUnit_GetOperations_MockSuccess(t *testing.T) {
 		}
 	})
 
+	t.Run("ListCAPWAPDataSummary", func(t *testing.T) {
+		result, err := service.ListCAPWAPDataSummary(ctx)
+		if err != nil {
+			t.Errorf("Expected no error for ListCAPWAPDataSummary, got: %v", err)
+		}
+		if result == nil {
+			t.Error("Expected result for ListCAPWAPDataSummary, got nil")
+		}
+	})
+
+	t.Run("StreamCAPWAPData", func(t *testing.T) {
+		count := 0
+		for entry, err := range service.StreamCAPWAPData(ctx) {
+			if err != nil {
+				t.Fatalf("Expected no error for StreamCAPWAPData, got: %v", err)
+			}
+			if entry.WtpMAC == "" {
+				t.Error("Expected WTP MAC in streamed CAPWAP data")
+			}
+			count++
+		}
+		if count == 0 {
+			t.Error("Expected entries from StreamCAPWAPData, got none")
+		}
+	})
+
 	t.Run("ListNameMACMaps", func(t *testing.T) {
 		result, err := service.ListNameMACMaps(ctx)
 		if err != nil {
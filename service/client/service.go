@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 	"strings"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
@@ -38,6 +39,12 @@ func (s Service) ListCommonInfo(ctx context.Context) (*CiscoIOSXEWirelessClientO
 	return core.Get[CiscoIOSXEWirelessClientOperCommonOperData](ctx, s.Client(), routes.ClientCommonOperDataPath)
 }
 
+// StreamCommonInfo yields common operational data entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListCommonInfo on large controllers.
+func (s Service) StreamCommonInfo(ctx context.Context, opts ...core.QueryOption) iter.Seq2[CommonOperData, error] {
+	return core.Stream[CommonOperData](ctx, s.Client(), routes.ClientCommonOperDataPath, opts...)
+}
+
 // GetCommonInfoByMAC retrieves client operational data filtered by MAC address.
 func (s Service) GetCommonInfoByMAC(
 	ctx context.Context, mac string,
@@ -79,6 +86,22 @@ func (s Service) ListDot11Info(ctx context.Context) (*CiscoIOSXEWirelessClientOp
 	return nil, err
 }
 
+// StreamDot11Info yields 802.11 operational data entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListDot11Info on large controllers.
+func (s Service) StreamDot11Info(ctx context.Context, opts ...core.QueryOption) iter.Seq2[Dot11OperData, error] {
+	return func(yield func(Dot11OperData, error) bool) {
+		for entry, err := range core.Stream[Dot11OperData](ctx, s.Client(), routes.ClientDot11OperDataPath, opts...) {
+			if err != nil && isKnownDot11OperationalDataIssue(err) {
+				// End the stream without error for IOS-XE 17.18.1 compatibility, as ListDot11Info does
+				return
+			}
+			if !yield(entry, err) {
+				return
+			}
+		}
+	}
+}
+
 // GetDot11InfoByMAC retrieves 802.11 operational data filtered by MAC address.
 func (s Service) GetDot11InfoByMAC(
 	ctx context.Context,
@@ -143,6 +166,12 @@ func (s Service) ListMobilityInfo(ctx context.Context) (*CiscoIOSXEWirelessClien
 	return core.Get[CiscoIOSXEWirelessClientOperMobilityOperData](ctx, s.Client(), routes.ClientMobilityOperDataPath)
 }
 
+// StreamMobilityInfo yields mobility operational data entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListMobilityInfo on large controllers.
+func (s Service) StreamMobilityInfo(ctx context.Context, opts ...core.QueryOption) iter.Seq2[MobilityOperData, error] {
+	return core.Stream[MobilityOperData](ctx, s.Client(), routes.ClientMobilityOperDataPath, opts...)
+}
+
 // GetMobilityInfoByMAC retrieves mobility operational data filtered by MAC address.
 func (s Service) GetMobilityInfoByMAC(
 	ctx context.Context,
@@ -179,6 +208,12 @@ func (s Service) ListSISFDB(ctx context.Context) (*CiscoIOSXEWirelessClientOperS
 	return core.Get[CiscoIOSXEWirelessClientOperSisfDBMac](ctx, s.Client(), routes.ClientSisfDBMacPath)
 }
 
+// StreamSISFDB yields SISF database MAC entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListSISFDB on large controllers.
+func (s Service) StreamSISFDB(ctx context.Context, opts ...core.QueryOption) iter.Seq2[SisfDBMac, error] {
+	return core.Stream[SisfDBMac](ctx, s.Client(), routes.ClientSisfDBMacPath, opts...)
+}
+
 // GetSISFDBByMAC retrieves sisf-db-mac for a specific client by MAC address.
 func (s Service) GetSISFDBByMAC(ctx context.Context, clientMAC string) (*CiscoIOSXEWirelessClientOperSisfDBMac, error) {
 	if clientMAC == "" || strings.TrimSpace(clientMAC) == "" {
@@ -194,6 +229,12 @@ func (s Service) ListTrafficStats(ctx context.Context) (*CiscoIOSXEWirelessClien
 	return core.Get[CiscoIOSXEWirelessClientOperTrafficStatsData](ctx, s.Client(), routes.ClientTrafficStatsPath)
 }
 
+// StreamTrafficStats yields traffic statistics entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListTrafficStats on large controllers.
+func (s Service) StreamTrafficStats(ctx context.Context, opts ...core.QueryOption) iter.Seq2[TrafficStats, error] {
+	return core.Stream[TrafficStats](ctx, s.Client(), routes.ClientTrafficStatsPath, opts...)
+}
+
 // GetTrafficStatsByMAC retrieves traffic-stats for a specific client by MAC address.
 func (s Service) GetTrafficStatsByMAC(
 	ctx context.Context,
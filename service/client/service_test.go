@@ -322,6 +322,18 @@ func TestClientServiceUnit_GetOperations_MockSuccess(t *testing.T) {
 		t.Error("Expected non-nil result from ListCommonInfo")
 	}
 
+	// Test StreamCommonInfo yields the same entries as ListCommonInfo
+	streamed := 0
+	for _, err := range service.StreamCommonInfo(ctx) {
+		if err != nil {
+			t.Fatalf("StreamCommonInfo failed: %v", err)
+		}
+		streamed++
+	}
+	if commonResult != nil && streamed != len(commonResult.CommonOperData) {
+		t.Errorf("Expected %d entries from StreamCommonInfo, got %d", len(commonResult.CommonOperData), streamed)
+	}
+
 	// Test GetCommonInfoByMAC with real WNC MAC address
 	commonByMAC, err := service.GetCommonInfoByMAC(ctx, "02:40:f1:f7:f7:87")
 	if err != nil {
@@ -615,6 +627,11 @@ func TestClientServiceUnit_KnownIssueHandling_Dot11Errors(t *testing.T) {
 	if resultByMAC == nil {
 		t.Error("Expected empty result for known issue, got nil")
 	}
+
+	// Test StreamDot11Info with known cursor issue - should end without entries or error
+	for entry, err := range service.StreamDot11Info(ctx) {
+		t.Errorf("Expected StreamDot11Info to handle known issue gracefully, got %+v, %v", entry, err)
+	}
 }
 
 // TestClientServiceUnit_KnownIssueHandling_GetOperationalErrors tests GetOperational known issue handling.
@@ -680,6 +697,11 @@ func TestClientServiceUnit_KnownIssueHandling_AdditionalDot11Errors(t *testing.T
 	if resultByMAC == nil {
 		t.Error("Expected empty result for DBAL error, got nil")
 	}
+
+	// Test StreamDot11Info with DBAL error - should end without entries or error
+	for entry, err := range service.StreamDot11Info(ctx) {
+		t.Errorf("Expected StreamDot11Info to handle DBAL error gracefully, got %+v, %v", entry, err)
+	}
 }
 
 // TestClientServiceUnit_KnownIssueHandling_UnknownErrors tests error scenarios that are not known issues.
@@ -711,6 +733,15 @@ func TestClientServiceUnit_KnownIssueHandling_UnknownErrors(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for unknown database error, got nil")
 	}
+
+	// Test StreamDot11Info with unknown error - should yield error (not gracefully handled)
+	var streamErr error
+	for _, err := range service.StreamDot11Info(ctx) {
+		streamErr = err
+	}
+	if streamErr == nil {
+		t.Error("Expected StreamDot11Info error for unknown database error, got nil")
+	}
 }
 
 // TestClientServiceUnit_KnownIssueHandling_GetOperationalUnknownErrors tests GetOperational with unknown errors.
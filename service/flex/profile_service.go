@@ -0,0 +1,240 @@
+package flex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/service"
+)
+
+// Flex profile limits enforced before write operations.
+const (
+	MaxFlexProfileNameLength = 32   // Maximum flex profile name length
+	MaxVLANNameLength        = 32   // Maximum VLAN name length of a VLAN mapping
+	MinVLANID                = 1    // Lowest VLAN ID of a VLAN mapping or native VLAN
+	MaxVLANID                = 4094 // Highest VLAN ID of a VLAN mapping or native VLAN
+)
+
+// FlexProfileService provides FlexConnect profile management functionality.
+type FlexProfileService struct {
+	service.BaseService
+}
+
+// NewFlexProfileService creates a new flex profile service.
+func NewFlexProfileService(client *core.Client) *FlexProfileService {
+	return &FlexProfileService{
+		BaseService: service.NewBaseService(client),
+	}
+}
+
+// GetFlexProfile retrieves a flex profile configuration by name.
+func (s *FlexProfileService) GetFlexProfile(ctx context.Context, profileName string) (*FlexPolicyEntry, error) {
+	if err := s.validateProfileName(profileName); err != nil {
+		return nil, err
+	}
+
+	result, err := core.Get[CiscoIOSXEWirelessFlexCfgFlexPolicyEntryPayload](
+		ctx, s.Client(), s.buildProfileURL(profileName))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.FlexPolicyEntry) == 0 {
+		return nil, nil
+	}
+
+	return &result.FlexPolicyEntry[0], nil
+}
+
+// ListFlexProfiles retrieves all flex profile configurations.
+func (s *FlexProfileService) ListFlexProfiles(ctx context.Context) ([]FlexPolicyEntry, error) {
+	result, err := NewService(s.Client()).ListFlexPolicyEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.FlexPolicyEntries.FlexPolicyEntry) == 0 {
+		return []FlexPolicyEntry{}, nil
+	}
+
+	return result.FlexPolicyEntries.FlexPolicyEntry, nil
+}
+
+// CreateFlexProfile creates a new flex profile configuration.
+func (s *FlexProfileService) CreateFlexProfile(ctx context.Context, config *FlexPolicyEntry) error {
+	if config == nil {
+		return errors.New("flex profile config cannot be nil")
+	}
+	if err := s.validateProfile(config); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessFlexCfgFlexPolicyEntriesPayload{FlexPolicyEntry: *config}
+	return core.PostVoid(ctx, s.Client(), routes.FlexPolicyEntriesPath, payload)
+}
+
+// UpdateFlexProfile merges the settings of config into an existing flex profile using PATCH operation.
+// VLAN mappings and ACLs in config are added to those of the profile.
+func (s *FlexProfileService) UpdateFlexProfile(ctx context.Context, config *FlexPolicyEntry) error {
+	if config == nil {
+		return errors.New("flex profile config cannot be nil")
+	}
+	if err := s.validateProfile(config); err != nil {
+		return err
+	}
+
+	return s.patchProfile(ctx, *config)
+}
+
+// DeleteFlexProfile deletes a flex profile configuration.
+func (s *FlexProfileService) DeleteFlexProfile(ctx context.Context, profileName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.Delete(ctx, s.Client(), s.buildProfileURL(profileName))
+}
+
+// AddVLANMapping maps a VLAN name used by WLAN policy profiles to a VLAN ID on the APs of a flex profile.
+// An existing mapping of the VLAN name is replaced.
+func (s *FlexProfileService) AddVLANMapping(ctx context.Context, profileName, vlanName string, vlanID int) error {
+	mapping := FlexIfNameVlanID{InterfaceName: vlanName, VlanID: vlanID}
+	if err := validateVLANMapping(mapping); err != nil {
+		return err
+	}
+	return s.patchProfile(ctx, FlexPolicyEntry{
+		PolicyName:    profileName,
+		IfNameVlanIDs: &FlexIfNameVlanIDs{IfNameVlanID: []FlexIfNameVlanID{mapping}},
+	})
+}
+
+// RemoveVLANMapping removes the VLAN mapping of a VLAN name from a flex profile.
+func (s *FlexProfileService) RemoveVLANMapping(ctx context.Context, profileName, vlanName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	if vlanName == "" {
+		return errors.New("VLAN name cannot be empty")
+	}
+	return core.Delete(ctx, s.Client(), s.Client().RESTCONFBuilder().BuildQueryURL(
+		s.buildProfileURL(profileName)+"/if-name-vlan-ids/if-name-vlan-id", vlanName))
+}
+
+// SetNativeVLAN sets the native VLAN of the AP uplinks of a flex profile and enables VLAN support.
+func (s *FlexProfileService) SetNativeVLAN(ctx context.Context, profileName string, vlanID int) error {
+	if err := validateVLANID(vlanID); err != nil {
+		return err
+	}
+	enabled := true
+	return s.patchProfile(ctx, FlexPolicyEntry{PolicyName: profileName, NativeVlanID: vlanID, VlanEnable: &enabled})
+}
+
+// AddPolicyACL adds a policy ACL to be pushed to the APs of a flex profile.
+func (s *FlexProfileService) AddPolicyACL(ctx context.Context, profileName string, acl FlexPolicyACL) error {
+	if acl.ACLName == "" {
+		return errors.New("ACL name cannot be empty")
+	}
+	return s.patchProfile(ctx, FlexPolicyEntry{PolicyName: profileName, PolicyACLs: []FlexPolicyACL{acl}})
+}
+
+// RemovePolicyACL removes a policy ACL from a flex profile.
+func (s *FlexProfileService) RemovePolicyACL(ctx context.Context, profileName, aclName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	if aclName == "" {
+		return errors.New("ACL name cannot be empty")
+	}
+	return core.Delete(ctx, s.Client(), s.Client().RESTCONFBuilder().BuildQueryURL(
+		s.buildProfileURL(profileName)+"/policy-acls", aclName))
+}
+
+// SetResilientMode enables or disables standalone operation of the APs when the controller is unreachable.
+func (s *FlexProfileService) SetResilientMode(ctx context.Context, profileName string, enabled bool) error {
+	return s.patchProfile(ctx, FlexPolicyEntry{PolicyName: profileName, IsResilientMode: &enabled})
+}
+
+// SetARPCaching enables or disables ARP caching of client addresses on the APs.
+func (s *FlexProfileService) SetARPCaching(ctx context.Context, profileName string, enabled bool) error {
+	return s.patchProfile(ctx, FlexPolicyEntry{PolicyName: profileName, ARPCaching: &enabled})
+}
+
+// SetLocalRoaming enables or disables distributed client data caching for roaming between the APs.
+func (s *FlexProfileService) SetLocalRoaming(ctx context.Context, profileName string, enabled bool) error {
+	return s.patchProfile(ctx, FlexPolicyEntry{PolicyName: profileName, IsLocalRoamingEnable: &enabled})
+}
+
+// SetEfficientAPUpgrade enables or disables AP image download from a primary AP of the site.
+func (s *FlexProfileService) SetEfficientAPUpgrade(ctx context.Context, profileName string, enabled bool) error {
+	return s.patchProfile(ctx, FlexPolicyEntry{PolicyName: profileName, EfficientAPUpgradeEnable: &enabled})
+}
+
+// patchProfile merges the settings of config into the flex profile it names. The controller rejects
+// the request when the profile does not exist.
+func (s *FlexProfileService) patchProfile(ctx context.Context, config FlexPolicyEntry) error {
+	if err := s.validateProfileName(config.PolicyName); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessFlexCfgFlexPolicyEntriesPayload{FlexPolicyEntry: config}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(config.PolicyName), payload)
+}
+
+// validateProfile validates the name, native VLAN and VLAN mappings of a flex profile.
+func (s *FlexProfileService) validateProfile(config *FlexPolicyEntry) error {
+	if err := s.validateProfileName(config.PolicyName); err != nil {
+		return err
+	}
+	if config.NativeVlanID != 0 {
+		if err := validateVLANID(config.NativeVlanID); err != nil {
+			return err
+		}
+	}
+	if config.IfNameVlanIDs != nil {
+		for _, mapping := range config.IfNameVlanIDs.IfNameVlanID {
+			if err := validateVLANMapping(mapping); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateProfileName validates flex profile name.
+func (s *FlexProfileService) validateProfileName(profileName string) error {
+	if profileName == "" {
+		return errors.New("flex profile name cannot be empty")
+	}
+	if strings.TrimSpace(profileName) == "" || len(profileName) > MaxFlexProfileNameLength {
+		return fmt.Errorf("flex profile validation failed: %w",
+			fmt.Errorf("invalid profile name format (max %d characters): '%s'", MaxFlexProfileNameLength, profileName))
+	}
+	return nil
+}
+
+// buildProfileURL builds URL for specific profile operations using RESTCONF builder.
+func (s *FlexProfileService) buildProfileURL(profileName string) string {
+	return s.Client().RESTCONFBuilder().BuildQueryURL(routes.FlexPolicyEntryByNamePath, profileName)
+}
+
+// validateVLANMapping validates the VLAN name and ID of a VLAN mapping.
+func validateVLANMapping(mapping FlexIfNameVlanID) error {
+	if mapping.InterfaceName == "" {
+		return errors.New("VLAN name cannot be empty")
+	}
+	if len(mapping.InterfaceName) > MaxVLANNameLength {
+		return fmt.Errorf("flex profile validation failed: %w",
+			fmt.Errorf("VLAN name too long (max %d characters): '%s'", MaxVLANNameLength, mapping.InterfaceName))
+	}
+	return validateVLANID(mapping.VlanID)
+}
+
+// validateVLANID validates a VLAN ID.
+func validateVLANID(vlanID int) error {
+	if vlanID < MinVLANID || vlanID > MaxVLANID {
+		return fmt.Errorf("flex profile validation failed: %w",
+			fmt.Errorf("VLAN ID range validation failed: %d not in %d-%d", vlanID, MinVLANID, MaxVLANID))
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package flex
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/pkg/testutil"
+)
+
+func TestFlexProfileServiceUnit_Constructor_Success(t *testing.T) {
+	service := NewService(nil)
+	if service.FlexProfile() == nil {
+		t.Error("Expected FlexProfile service, got nil")
+	}
+}
+
+func TestFlexProfileServiceUnit_Operations_MockSuccess(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller serving one flex profile and recording write requests
+	entriesPath := "Cisco-IOS-XE-wireless-flex-cfg:flex-cfg-data/flex-policy-entries"
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponses(map[string]string{
+			entriesPath: `{"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entries":{"flex-policy-entry":[` +
+				`{"policy-name":"default-flex-profile"},{"policy-name":"branch"}]}}`,
+			entriesPath + "/flex-policy-entry=branch": `{"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entry":[` +
+				`{"policy-name":"branch","if-name-vlan-ids":{"if-name-vlan-id":[{"interface-name":"corp","vlan-id":10}]}}]}`,
+		}),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+
+	client := testutil.NewTestClient(server).Core().(*core.Client)
+	profiles := NewFlexProfileService(client)
+	ctx := testutil.TestContext(t)
+
+	profile, err := profiles.GetFlexProfile(ctx, "branch")
+	if err != nil || profile == nil {
+		t.Fatalf("GetFlexProfile failed: %v, %+v", err, profile)
+	}
+	if profile.IfNameVlanIDs == nil || profile.IfNameVlanIDs.IfNameVlanID[0].VlanID != 10 {
+		t.Errorf("Unexpected flex profile: %+v", profile)
+	}
+	list, err := profiles.ListFlexProfiles(ctx)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListFlexProfiles failed: %v, %+v", err, list)
+	}
+
+	steps := []struct {
+		name string
+		call func() error
+	}{
+		{"CreateFlexProfile", func() error {
+			return profiles.CreateFlexProfile(ctx, &FlexPolicyEntry{PolicyName: "branch", NativeVlanID: 100})
+		}},
+		{"UpdateFlexProfile", func() error {
+			return profiles.UpdateFlexProfile(ctx, &FlexPolicyEntry{PolicyName: "branch", Description: "Branch APs"})
+		}},
+		{"AddVLANMapping", func() error { return profiles.AddVLANMapping(ctx, "branch", "guest", 20) }},
+		{"RemoveVLANMapping", func() error { return profiles.RemoveVLANMapping(ctx, "branch", "corp") }},
+		{"SetNativeVLAN", func() error { return profiles.SetNativeVLAN(ctx, "branch", 99) }},
+		{"AddPolicyACL", func() error { return profiles.AddPolicyACL(ctx, "branch", FlexPolicyACL{ACLName: "preauth"}) }},
+		{"RemovePolicyACL", func() error { return profiles.RemovePolicyACL(ctx, "branch", "preauth") }},
+		{"SetResilientMode", func() error { return profiles.SetResilientMode(ctx, "branch", false) }},
+		{"SetARPCaching", func() error { return profiles.SetARPCaching(ctx, "branch", true) }},
+		{"SetLocalRoaming", func() error { return profiles.SetLocalRoaming(ctx, "branch", true) }},
+		{"SetEfficientAPUpgrade", func() error { return profiles.SetEfficientAPUpgrade(ctx, "branch", false) }},
+		{"DeleteFlexProfile", func() error { return profiles.DeleteFlexProfile(ctx, "branch") }},
+	}
+	for _, step := range steps {
+		if err := step.call(); err != nil {
+			t.Fatalf("%s failed: %v", step.name, err)
+		}
+	}
+
+	postPath := "/restconf/data/" + entriesPath
+	profilePath := postPath + "/flex-policy-entry=branch"
+	entry := func(settings string) string {
+		return `{"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entry":{"policy-name":"branch"` + settings + `}}`
+	}
+	expected := []struct{ method, path, body string }{
+		{http.MethodPost, postPath, entry(`,"native-vlan-id":100`)},
+		{http.MethodPatch, profilePath, entry(`,"description":"Branch APs"`)},
+		{http.MethodPatch, profilePath,
+			entry(`,"if-name-vlan-ids":{"if-name-vlan-id":[{"interface-name":"guest","vlan-id":20}]}`)},
+		{http.MethodDelete, profilePath + "/if-name-vlan-ids/if-name-vlan-id=corp", ""},
+		{http.MethodPatch, profilePath, entry(`,"vlan-enable":true,"native-vlan-id":99`)},
+		{http.MethodPatch, profilePath, entry(`,"policy-acls":[{"acl-name":"preauth"}]`)},
+		{http.MethodDelete, profilePath + "/policy-acls=preauth", ""},
+		{http.MethodPatch, profilePath, entry(`,"is-resilient-mode":false`)},
+		{http.MethodPatch, profilePath, entry(`,"arp-caching":true`)},
+		{http.MethodPatch, profilePath, entry(`,"is-local-roaming-enable":true`)},
+		{http.MethodPatch, profilePath, entry(`,"efficient-ap-upgrade-enable":false`)},
+		{http.MethodDelete, profilePath, ""},
+	}
+	writes := recorder.Writes()
+	if len(writes) != len(expected) {
+		t.Fatalf("Expected %d write requests, got %d", len(expected), len(writes))
+	}
+	for i, want := range expected {
+		got := writes[i]
+		if got.Method != want.method || got.Path != want.path || got.Body != want.body {
+			t.Errorf("Request %d: expected %s %s %s, got %s %s %s",
+				i, want.method, want.path, want.body, got.Method, got.Path, got.Body)
+		}
+	}
+}
+
+func TestFlexProfileServiceUnit_ValidationErrors(t *testing.T) {
+	profiles := NewFlexProfileService(nil)
+	ctx := testutil.TestContext(t)
+	longName := strings.Repeat("f", MaxFlexProfileNameLength+1)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"GetEmptyName", func() error { _, err := profiles.GetFlexProfile(ctx, ""); return err }},
+		{"CreateNil", func() error { return profiles.CreateFlexProfile(ctx, nil) }},
+		{"CreateLongName", func() error { return profiles.CreateFlexProfile(ctx, &FlexPolicyEntry{PolicyName: longName}) }},
+		{"CreateNativeVLANOutOfRange", func() error {
+			return profiles.CreateFlexProfile(ctx, &FlexPolicyEntry{PolicyName: "branch", NativeVlanID: 4095})
+		}},
+		{"CreateInvalidVLANMapping", func() error {
+			return profiles.CreateFlexProfile(ctx, &FlexPolicyEntry{PolicyName: "branch", IfNameVlanIDs: &FlexIfNameVlanIDs{
+				IfNameVlanID: []FlexIfNameVlanID{{InterfaceName: "guest"}},
+			}})
+		}},
+		{"UpdateNil", func() error { return profiles.UpdateFlexProfile(ctx, nil) }},
+		{"DeleteBlankName", func() error { return profiles.DeleteFlexProfile(ctx, " ") }},
+		{"MappingEmptyVLANName", func() error { return profiles.AddVLANMapping(ctx, "branch", "", 10) }},
+		{"MappingLongVLANName", func() error {
+			return profiles.AddVLANMapping(ctx, "branch", strings.Repeat("v", MaxVLANNameLength+1), 10)
+		}},
+		{"MappingVLANIDZero", func() error { return profiles.AddVLANMapping(ctx, "branch", "guest", 0) }},
+		{"MappingEmptyProfileName", func() error { return profiles.AddVLANMapping(ctx, "", "guest", 10) }},
+		{"RemoveMappingEmptyVLANName", func() error { return profiles.RemoveVLANMapping(ctx, "branch", "") }},
+		{"NativeVLANOutOfRange", func() error { return profiles.SetNativeVLAN(ctx, "branch", MaxVLANID+1) }},
+		{"ACLEmptyName", func() error { return profiles.AddPolicyACL(ctx, "branch", FlexPolicyACL{}) }},
+		{"RemoveACLEmptyName", func() error { return profiles.RemovePolicyACL(ctx, "branch", "") }},
+		{"FlagEmptyProfileName", func() error { return profiles.SetResilientMode(ctx, "", true) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err == nil {
+				t.Errorf("Expected validation error for %s", tt.name)
+			}
+		})
+	}
+}
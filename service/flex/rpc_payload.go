@@ -0,0 +1,11 @@
+package flex
+
+// CiscoIOSXEWirelessFlexCfgFlexPolicyEntryPayload represents individual flex policy entry response structure.
+type CiscoIOSXEWirelessFlexCfgFlexPolicyEntryPayload struct {
+	FlexPolicyEntry []FlexPolicyEntry `json:"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entry"`
+}
+
+// CiscoIOSXEWirelessFlexCfgFlexPolicyEntriesPayload represents request structure for flex-policy-entries endpoint.
+type CiscoIOSXEWirelessFlexCfgFlexPolicyEntriesPayload struct {
+	FlexPolicyEntry FlexPolicyEntry `json:"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entry"`
+}
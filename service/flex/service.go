@@ -18,6 +18,11 @@ func NewService(client *core.Client) Service {
 	return Service{BaseService: service.NewBaseService(client)}
 }
 
+// FlexProfile returns a flex profile service instance for flex profile management operations.
+func (s Service) FlexProfile() *FlexProfileService {
+	return NewFlexProfileService(s.Client())
+}
+
 // GetConfig retrieves FlexConnect configuration data.
 func (s Service) GetConfig(ctx context.Context) (*CiscoIOSXEWirelessFlexCfg, error) {
 	return core.Get[CiscoIOSXEWirelessFlexCfg](ctx, s.Client(), routes.FlexCfgPath)
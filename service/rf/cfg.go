@@ -16,6 +16,11 @@ type CiscoIOSXEWirelessRFCfgRFTags struct {
 	RFTags RFTags `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-tags"`
 }
 
+// CiscoIOSXEWirelessRFCfgRFProfiles represents RF profiles list response structure.
+type CiscoIOSXEWirelessRFCfgRFProfiles struct {
+	RFProfiles RFProfiles `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-profiles"`
+}
+
 // MultiBssidProfiles represents Multi-BSSID profiles collection.
 type MultiBssidProfiles struct {
 	MultiBssidProfileList []MultiBssidProfile `json:"multi-bssid-profile"` // Multi BSSID profile list (Live: IOS-XE 17.12.6a)
@@ -85,8 +90,8 @@ type RFTagRadioProfile struct {
 type RFProfileDetail struct {
 	Name                             string                `json:"name"`                                           // RF profile name identifier (Live: IOS-XE 17.12.6a)
 	Description                      string                `json:"description,omitempty"`                          // RF profile description (YANG: IOS-XE 17.12.1)
-	TxPowerMin                       int                   `json:"tx-power-min,omitempty"`                         // Minimum transmit power in dBm (Live: IOS-XE 17.12.6a)
-	TxPowerMax                       int                   `json:"tx-power-max,omitempty"`                         // Maximum transmit power in dBm (YANG: IOS-XE 17.12.1)
+	TxPowerMin                       *int                  `json:"tx-power-min,omitempty"`                         // Minimum transmit power in dBm (Live: IOS-XE 17.12.6a)
+	TxPowerMax                       *int                  `json:"tx-power-max,omitempty"`                         // Maximum transmit power in dBm (YANG: IOS-XE 17.12.1)
 	TxPowerV1Threshold               int                   `json:"tx-power-v1-threshold,omitempty"`                // TPC version 1 threshold in dBm (Live: IOS-XE 17.12.6a)
 	TxPowerV2Threshold               int                   `json:"tx-power-v2-threshold,omitempty"`                // TPC version 2 threshold in dBm (YANG: IOS-XE 17.12.1)
 	Status                           bool                  `json:"status,omitempty"`                               // RF profile operational state (Live: IOS-XE 17.12.6a)
@@ -102,6 +107,7 @@ type RFProfileDetail struct {
 	DataRate24M                      string                `json:"data-rate-24m,omitempty"`                        // 24 Mbps data rate state (Live: IOS-XE 17.12.6a)
 	DataRate36M                      string                `json:"data-rate-36m,omitempty"`                        // 36 Mbps data rate state (YANG: IOS-XE 17.12.1)
 	DataRate48M                      string                `json:"data-rate-48m,omitempty"`                        // 48 Mbps data rate state (YANG: IOS-XE 17.12.1)
+	DataRate54M                      string                `json:"data-rate-54m,omitempty"`                        // 54 Mbps data rate state (YANG: IOS-XE 17.12.1)
 	CoverageDataPacketRSSIThreshold  int                   `json:"coverage-data-packet-rssi-threshold,omitempty"`  // Data packet RSSI threshold dBm (YANG: IOS-XE 17.12.1)
 	CoverageVoicePacketRSSIThreshold int                   `json:"coverage-voice-packet-rssi-threshold,omitempty"` // Voice packet RSSI threshold dBm (YANG: IOS-XE 17.12.1)
 	LoadBalancingWindow              int                   `json:"load-balancing-window,omitempty"`                // Load balancing window seconds (Live: IOS-XE 17.12.6a)
@@ -112,9 +118,22 @@ type RFProfileDetail struct {
 	RfdcaRemovedChannels             *RfdcaRemovedChannels `json:"rfdca-removed-channels,omitempty"`               // RF DCA removed channels data (Live: IOS-XE 17.12.6a)
 	ChannelWidthMax                  string                `json:"channel-width-max,omitempty"`                    // Maximum channel width cap (Live: IOS-XE 17.12.6a)
 	MinNumClients                    int                   `json:"min-num-clients,omitempty"`                      // Minimum client exception level (YANG: IOS-XE 17.12.1)
+	MaxClients                       int                   `json:"max-clients,omitempty"`                          // Maximum clients per radio (YANG: IOS-XE 17.12.1)
 	RxSenSopThreshold                string                `json:"rx-sen-sop-threshold,omitempty"`                 // RX SOP sensitivity threshold (YANG: IOS-XE 17.12.1)
 	BandSelectProbeResponse          bool                  `json:"band-select-probe-response,omitempty"`           // Band select probe response flag (YANG: IOS-XE 17.12.1)
-}
+	BandSelectCycleCount             int                   `json:"band-select-cycle-count,omitempty"`              // Band select probe cycle count (YANG: IOS-XE 17.12.1)
+	BandSelectCycleThreshold         int                   `json:"band-select-cycle-threshold,omitempty"`          // Band select cycle threshold in ms (YANG: IOS-XE 17.12.1)
+	BandSelectExpireSuppression      int                   `json:"band-select-expire-suppression,omitempty"`       // Band select suppression expiry in seconds (YANG: IOS-XE 17.12.1)
+	BandSelectExpireDualBand         int                   `json:"band-select-expire-dual-band,omitempty"`         // Band select dual-band expiry in seconds (YANG: IOS-XE 17.12.1)
+	BandSelectClientRSSI             int                   `json:"band-select-client-rssi,omitempty"`              // Band select client RSSI threshold dBm (YANG: IOS-XE 17.12.1)
+}
+
+// Radio bands of RFProfileDetail.Band.
+const (
+	RFBand24GHz = "dot11-2-dot-4-ghz-band" // 2.4 GHz band (802.11b/g/n/ax)
+	RFBand5GHz  = "dot11-5-ghz-band"       // 5 GHz band (802.11a/n/ac/ax)
+	RFBand6GHz  = "dot11-6-ghz-band"       // 6 GHz band (802.11ax)
+)
 
 // RFMcsEntries represents RF MCS entries collection.
 type RFMcsEntries struct {
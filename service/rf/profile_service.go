@@ -0,0 +1,342 @@
+package rf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/service"
+)
+
+// RF profile limits enforced before write operations.
+const (
+	MaxRFProfileNameLength = 32   // Maximum RF profile name length
+	MinTxPower             = -10  // Lowest TPC power level in dBm
+	MaxTxPower             = 30   // Highest TPC power level in dBm
+	MinCoverageRSSI        = -90  // Lowest coverage hole RSSI threshold in dBm
+	MaxCoverageRSSI        = -60  // Highest coverage hole RSSI threshold in dBm
+	MaxCoverageMinClients  = 200  // Maximum coverage exception client count
+	MaxClientsPerRadio     = 500  // Maximum client limit per radio
+	MaxBandSelectCycles    = 10   // Maximum band select probe cycle count
+	MaxBandSelectThreshold = 1000 // Maximum band select cycle threshold in ms
+	MinBandSelectExpire    = 10   // Minimum band select expiry in seconds
+	MaxBandSelectSuppress  = 200  // Maximum band select suppression expiry in seconds
+	MaxBandSelectDualBand  = 300  // Maximum band select dual-band expiry in seconds
+	MinBandSelectRSSI      = -90  // Lowest band select client RSSI in dBm
+	MaxBandSelectRSSI      = -20  // Highest band select client RSSI in dBm
+)
+
+// rfBandLimits describes what a radio band supports.
+type rfBandLimits struct {
+	channels        []int // Channels DCA can assign
+	maxChannelWidth int   // Widest channel in MHz
+	dsssRates       bool  // 1, 2, 5.5 and 11 Mbps rates
+	bandSelect      bool  // Band select steers clients away from the band
+}
+
+// rfBands lists the limits of each band of RFProfileDetail.Band.
+var rfBands = map[string]rfBandLimits{
+	RFBand24GHz: {channels: channelRange(1, 14, 1), maxChannelWidth: 20, dsssRates: true, bandSelect: true},
+	RFBand5GHz: {
+		channels:        slices.Concat(channelRange(36, 64, 4), channelRange(100, 144, 4), channelRange(149, 177, 4)),
+		maxChannelWidth: 160,
+	},
+	RFBand6GHz: {channels: channelRange(1, 233, 4), maxChannelWidth: 160},
+}
+
+// RFProfileService provides RF profile management functionality.
+type RFProfileService struct {
+	service.BaseService
+}
+
+// NewRFProfileService creates a new RF profile service.
+func NewRFProfileService(client *core.Client) *RFProfileService {
+	return &RFProfileService{
+		BaseService: service.NewBaseService(client),
+	}
+}
+
+// GetRFProfile retrieves an RF profile configuration by name.
+func (s *RFProfileService) GetRFProfile(ctx context.Context, profileName string) (*RFProfileDetail, error) {
+	if err := s.validateProfileName(profileName); err != nil {
+		return nil, err
+	}
+
+	result, err := core.Get[CiscoIOSXEWirelessRFCfgRFProfilePayload](ctx, s.Client(), s.buildProfileURL(profileName))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.RFProfiles) == 0 {
+		return nil, nil
+	}
+
+	return &result.RFProfiles[0], nil
+}
+
+// ListRFProfiles retrieves all RF profile configurations.
+func (s *RFProfileService) ListRFProfiles(ctx context.Context) ([]RFProfileDetail, error) {
+	result, err := core.Get[CiscoIOSXEWirelessRFCfgRFProfiles](ctx, s.Client(), routes.RFProfilesPath)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.RFProfiles.RFProfileList) == 0 {
+		return []RFProfileDetail{}, nil
+	}
+
+	return result.RFProfiles.RFProfileList, nil
+}
+
+// CreateRFProfile creates a new RF profile configuration. The band is required, since the
+// settings are validated against what the band supports.
+func (s *RFProfileService) CreateRFProfile(ctx context.Context, config *RFProfileDetail) error {
+	if config == nil {
+		return errors.New("RF profile config cannot be nil")
+	}
+	if err := s.validateProfileName(config.Name); err != nil {
+		return err
+	}
+	if err := validateRFProfile(config, config.Band); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessRFCfgRFProfilesPayload{RFProfile: *config}
+	return core.PostVoid(ctx, s.Client(), routes.RFProfilesPath, payload)
+}
+
+// UpdateRFProfile merges the settings of config into an existing RF profile using PATCH operation.
+// The settings are validated against the band of the existing profile, which cannot be changed, and
+// TX power limits against those of the existing profile.
+func (s *RFProfileService) UpdateRFProfile(ctx context.Context, config *RFProfileDetail) error {
+	if config == nil {
+		return errors.New("RF profile config cannot be nil")
+	}
+	existing, err := s.getExistingProfile(ctx, config.Name)
+	if err != nil {
+		return err
+	}
+	if config.Band != "" && config.Band != existing.Band {
+		return fmt.Errorf("RF profile validation failed: %w",
+			fmt.Errorf("band cannot be changed from '%s' to '%s'", existing.Band, config.Band))
+	}
+	if err := validateRFProfile(config, existing.Band); err != nil {
+		return err
+	}
+	txPowerMin, txPowerMax := config.TxPowerMin, config.TxPowerMax
+	if txPowerMin == nil {
+		txPowerMin = existing.TxPowerMin
+	}
+	if txPowerMax == nil {
+		txPowerMax = existing.TxPowerMax
+	}
+	if err := validateTxPowerOrder(txPowerMin, txPowerMax); err != nil {
+		return fmt.Errorf("RF profile validation failed: %w", err)
+	}
+
+	payload := CiscoIOSXEWirelessRFCfgRFProfilesPayload{RFProfile: *config}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(config.Name), payload)
+}
+
+// DeleteRFProfile deletes an RF profile configuration.
+func (s *RFProfileService) DeleteRFProfile(ctx context.Context, profileName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.Delete(ctx, s.Client(), s.buildProfileURL(profileName))
+}
+
+// SetDCAChannels sets the channels DCA may assign to radios using an RF profile. The profile stores
+// the channels of its band removed from DCA, which are replaced accordingly.
+func (s *RFProfileService) SetDCAChannels(ctx context.Context, profileName string, channels []int) error {
+	if len(channels) == 0 {
+		return errors.New("DCA channels cannot be empty")
+	}
+	profile, err := s.getExistingProfile(ctx, profileName)
+	if err != nil {
+		return err
+	}
+	limits, err := bandLimits(profile.Band)
+	if err != nil {
+		return err
+	}
+	for _, channel := range channels {
+		if !slices.Contains(limits.channels, channel) {
+			return fmt.Errorf("RF profile validation failed: %w",
+				fmt.Errorf("DCA channel %d is not a channel of band '%s'", channel, profile.Band))
+		}
+	}
+
+	removed := RfdcaRemovedChannels{RfdcaRemovedChannel: []RfdcaRemovedChannel{}}
+	for _, channel := range limits.channels {
+		if !slices.Contains(channels, channel) {
+			removed.RfdcaRemovedChannel = append(removed.RfdcaRemovedChannel, RfdcaRemovedChannel{Channel: channel})
+		}
+	}
+
+	url := s.buildProfileURL(profileName) + "/rfdca-removed-channels"
+	if len(removed.RfdcaRemovedChannel) == 0 {
+		if profile.RfdcaRemovedChannels == nil || len(profile.RfdcaRemovedChannels.RfdcaRemovedChannel) == 0 {
+			return nil
+		}
+		return core.Delete(ctx, s.Client(), url)
+	}
+	payload := struct {
+		Removed RfdcaRemovedChannels `json:"Cisco-IOS-XE-wireless-rf-cfg:rfdca-removed-channels"`
+	}{Removed: removed}
+	return core.PutVoid(ctx, s.Client(), url, payload)
+}
+
+// getExistingProfile retrieves an RF profile that must exist.
+func (s *RFProfileService) getExistingProfile(ctx context.Context, profileName string) (*RFProfileDetail, error) {
+	profile, err := s.GetRFProfile(ctx, profileName)
+	if err != nil {
+		return nil, fmt.Errorf("RF profile operation failed: %w",
+			fmt.Errorf("profile retrieval failed for '%s': %w", profileName, err))
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("RF profile operation failed: %w",
+			fmt.Errorf("profile '%s' not found in controller configuration", profileName))
+	}
+	return profile, nil
+}
+
+// validateProfileName validates RF profile name.
+func (s *RFProfileService) validateProfileName(profileName string) error {
+	if profileName == "" {
+		return errors.New("RF profile name cannot be empty")
+	}
+	if strings.TrimSpace(profileName) == "" || len(profileName) > MaxRFProfileNameLength {
+		return fmt.Errorf("RF profile validation failed: %w",
+			fmt.Errorf("invalid profile name format (max %d characters): '%s'", MaxRFProfileNameLength, profileName))
+	}
+	return nil
+}
+
+// buildProfileURL builds URL for specific profile operations using RESTCONF builder.
+func (s *RFProfileService) buildProfileURL(profileName string) string {
+	return s.Client().RESTCONFBuilder().BuildQueryURL(routes.RFProfileByNamePath, profileName)
+}
+
+// bandLimits returns the limits of a band of RFProfileDetail.Band.
+func bandLimits(band string) (rfBandLimits, error) {
+	limits, ok := rfBands[band]
+	if !ok {
+		return rfBandLimits{}, fmt.Errorf("RF profile validation failed: %w",
+			fmt.Errorf("unsupported band '%s': expected '%s', '%s' or '%s'", band, RFBand24GHz, RFBand5GHz, RFBand6GHz))
+	}
+	return limits, nil
+}
+
+// validateRFProfile validates the settings of config against the band. Zero values and nil TX power
+// limits are not sent and are therefore not validated.
+func validateRFProfile(config *RFProfileDetail, band string) error {
+	limits, err := bandLimits(band)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if !limits.dsssRates {
+		rates := []struct{ rate, state string }{
+			{"1", config.DataRate1M}, {"2", config.DataRate2M}, {"5.5", config.DataRate5_5M}, {"11", config.DataRate11M},
+		}
+		for _, r := range rates {
+			if r.state != "" {
+				errs = append(errs, fmt.Errorf("%s Mbps data rate is not supported on band '%s'", r.rate, band))
+			}
+		}
+	}
+
+	errs = append(errs,
+		checkTxPower("minimum TX power", config.TxPowerMin),
+		checkTxPower("maximum TX power", config.TxPowerMax),
+		validateTxPowerOrder(config.TxPowerMin, config.TxPowerMax),
+		checkRange("data packet coverage RSSI threshold", config.CoverageDataPacketRSSIThreshold,
+			MinCoverageRSSI, MaxCoverageRSSI),
+		checkRange("voice packet coverage RSSI threshold", config.CoverageVoicePacketRSSIThreshold,
+			MinCoverageRSSI, MaxCoverageRSSI),
+		checkRange("coverage minimum clients", config.MinNumClients, 1, MaxCoverageMinClients),
+		checkRange("maximum clients", config.MaxClients, 1, MaxClientsPerRadio),
+	)
+
+	if config.RfdcaRemovedChannels != nil {
+		for _, removed := range config.RfdcaRemovedChannels.RfdcaRemovedChannel {
+			if !slices.Contains(limits.channels, removed.Channel) {
+				errs = append(errs, fmt.Errorf("DCA channel %d is not a channel of band '%s'", removed.Channel, band))
+			}
+		}
+	}
+	if width := channelWidthMHz(config.ChannelWidthMax); width > limits.maxChannelWidth {
+		errs = append(errs, fmt.Errorf("channel width %d MHz exceeds %d MHz supported on band '%s'",
+			width, limits.maxChannelWidth, band))
+	}
+
+	bandSelect := config.BandSelectProbeResponse || config.BandSelectCycleCount != 0 ||
+		config.BandSelectCycleThreshold != 0 || config.BandSelectExpireSuppression != 0 ||
+		config.BandSelectExpireDualBand != 0 || config.BandSelectClientRSSI != 0
+	if bandSelect && !limits.bandSelect {
+		errs = append(errs, fmt.Errorf("band select is only supported on band '%s'", RFBand24GHz))
+	}
+	errs = append(errs,
+		checkRange("band select cycle count", config.BandSelectCycleCount, 1, MaxBandSelectCycles),
+		checkRange("band select cycle threshold", config.BandSelectCycleThreshold, 1, MaxBandSelectThreshold),
+		checkRange("band select suppression expiry", config.BandSelectExpireSuppression,
+			MinBandSelectExpire, MaxBandSelectSuppress),
+		checkRange("band select dual-band expiry", config.BandSelectExpireDualBand,
+			MinBandSelectExpire, MaxBandSelectDualBand),
+		checkRange("band select client RSSI", config.BandSelectClientRSSI, MinBandSelectRSSI, MaxBandSelectRSSI),
+	)
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("RF profile validation failed: %w", err)
+	}
+	return nil
+}
+
+// checkTxPower reports a set TX power limit outside the TPC power range. 0 dBm is a valid limit.
+func checkTxPower(name string, value *int) error {
+	if value != nil && (*value < MinTxPower || *value > MaxTxPower) {
+		return fmt.Errorf("%s range validation failed: %d not in %d-%d", name, *value, MinTxPower, MaxTxPower)
+	}
+	return nil
+}
+
+// validateTxPowerOrder reports a minimum TX power above the maximum when both are set.
+func validateTxPowerOrder(minPower, maxPower *int) error {
+	if minPower != nil && maxPower != nil && *minPower > *maxPower {
+		return fmt.Errorf("minimum TX power %d dBm exceeds maximum TX power %d dBm", *minPower, *maxPower)
+	}
+	return nil
+}
+
+// checkRange reports a set value outside min-max.
+func checkRange(name string, value, minValue, maxValue int) error {
+	if value != 0 && (value < minValue || value > maxValue) {
+		return fmt.Errorf("%s range validation failed: %d not in %d-%d", name, value, minValue, maxValue)
+	}
+	return nil
+}
+
+// channelWidthMHz returns the width named by a channel width value such as
+// "radio-neighbor-chan-width-40-mhz", or 0 for values without width like "best".
+func channelWidthMHz(value string) int {
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r < '0' || r > '9' }) {
+		if width, err := strconv.Atoi(part); err == nil {
+			return width
+		}
+	}
+	return 0
+}
+
+// channelRange returns the channels from first to last in steps.
+func channelRange(first, last, step int) []int {
+	var channels []int
+	for channel := first; channel <= last; channel += step {
+		channels = append(channels, channel)
+	}
+	return channels
+}
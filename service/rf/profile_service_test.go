@@ -0,0 +1,182 @@
+package rf
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/pkg/testutil"
+)
+
+func TestRfProfileServiceUnit_Constructor_Success(t *testing.T) {
+	service := NewService(nil)
+	if service.RFProfile() == nil {
+		t.Error("Expected RFProfile service, got nil")
+	}
+}
+
+func TestRfProfileServiceUnit_Operations_MockSuccess(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller serving a 5 GHz and a 2.4 GHz profile and recording write requests
+	profilesPath := "Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-profiles"
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponses(map[string]string{
+			profilesPath: `{"Cisco-IOS-XE-wireless-rf-cfg:rf-profiles":{"rf-profile":[` +
+				`{"name":"high-density-5","band":"dot11-5-ghz-band"},{"name":"typical-24","band":"dot11-2-dot-4-ghz-band"}]}}`,
+			profilesPath + "/rf-profile=high-density-5": `{"Cisco-IOS-XE-wireless-rf-cfg:rf-profile":[` +
+				`{"name":"high-density-5","band":"dot11-5-ghz-band","tx-power-min":7,"tx-power-max":17}]}`,
+			profilesPath + "/rf-profile=typical-24": `{"Cisco-IOS-XE-wireless-rf-cfg:rf-profile":[{"name":"typical-24",` +
+				`"band":"dot11-2-dot-4-ghz-band","rfdca-removed-channels":{"rfdca-removed-channel":[{"channel":14}]}}]}`,
+		}),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+
+	client := testutil.NewTestClient(server).Core().(*core.Client)
+	profiles := NewRFProfileService(client)
+	ctx := testutil.TestContext(t)
+
+	profile, err := profiles.GetRFProfile(ctx, "high-density-5")
+	if err != nil || profile == nil {
+		t.Fatalf("GetRFProfile failed: %v, %+v", err, profile)
+	}
+	if profile.Band != RFBand5GHz || profile.TxPowerMin == nil || *profile.TxPowerMin != 7 || *profile.TxPowerMax != 17 {
+		t.Errorf("Unexpected RF profile: %+v", profile)
+	}
+	list, err := profiles.ListRFProfiles(ctx)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListRFProfiles failed: %v, %+v", err, list)
+	}
+
+	config := &RFProfileDetail{
+		Name: "high-density-5", Band: RFBand5GHz, Status: true,
+		DataRate6M: "disabled", DataRate24M: "mandatory", TxPowerMin: dBm(0), TxPowerMax: dBm(17),
+		ChannelWidthMax: "radio-neighbor-chan-width-40-mhz", MaxClients: 100,
+		RfdcaRemovedChannels: &RfdcaRemovedChannels{RfdcaRemovedChannel: []RfdcaRemovedChannel{{Channel: 144}}},
+	}
+	if err := profiles.CreateRFProfile(ctx, config); err != nil {
+		t.Fatalf("CreateRFProfile failed: %v", err)
+	}
+	if err := profiles.UpdateRFProfile(ctx, &RFProfileDetail{Name: "high-density-5", TxPowerMin: dBm(10)}); err != nil {
+		t.Fatalf("UpdateRFProfile failed: %v", err)
+	}
+	err = profiles.UpdateRFProfile(ctx, &RFProfileDetail{Name: "high-density-5", TxPowerMin: dBm(20)})
+	if err == nil || !strings.Contains(err.Error(), "minimum TX power 20 dBm exceeds maximum TX power 17 dBm") {
+		t.Errorf("Expected minimum TX power above the existing maximum to be rejected, got %v", err)
+	}
+	if err := profiles.SetDCAChannels(ctx, "high-density-5", []int{36, 40, 44, 48}); err != nil {
+		t.Fatalf("SetDCAChannels failed: %v", err)
+	}
+	allChannels := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}
+	if err := profiles.SetDCAChannels(ctx, "typical-24", allChannels); err != nil {
+		t.Fatalf("SetDCAChannels failed: %v", err)
+	}
+	if err := profiles.DeleteRFProfile(ctx, "high-density-5"); err != nil {
+		t.Fatalf("DeleteRFProfile failed: %v", err)
+	}
+
+	profilePath := "/restconf/data/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-profiles/rf-profile="
+	expected := []struct{ method, path, contains string }{
+		{http.MethodPost, "/restconf/data/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-profiles",
+			`"Cisco-IOS-XE-wireless-rf-cfg:rf-profile":{"name":"high-density-5"`},
+		{http.MethodPatch, profilePath + "high-density-5", `"tx-power-min":10`},
+		{http.MethodPut, profilePath + "high-density-5/rfdca-removed-channels",
+			`{"rfdca-removed-channel":[{"channel":52},{"channel":56}`},
+		{http.MethodDelete, profilePath + "typical-24/rfdca-removed-channels", ""},
+		{http.MethodDelete, profilePath + "high-density-5", ""},
+	}
+	writes := recorder.Writes()
+	if len(writes) != len(expected) {
+		t.Fatalf("Expected %d write requests, got %d", len(expected), len(writes))
+	}
+	for i, want := range expected {
+		got := writes[i]
+		if got.Method != want.method || got.Path != want.path || !strings.Contains(got.Body, want.contains) {
+			t.Errorf("Request %d: expected %s %s containing %q, got %s %s %s",
+				i, want.method, want.path, want.contains, got.Method, got.Path, got.Body)
+		}
+	}
+	if !strings.Contains(writes[0].Body, `"tx-power-min":0,"tx-power-max":17`) {
+		t.Errorf("Expected 0 dBm minimum TX power to be sent, got %s", writes[0].Body)
+	}
+	if strings.Contains(writes[2].Body, `"channel":36`) {
+		t.Errorf("Expected DCA channels to be kept, got %s", writes[2].Body)
+	}
+}
+
+func TestRfProfileServiceUnit_ValidationErrors(t *testing.T) {
+	profiles := NewRFProfileService(nil)
+	ctx := testutil.TestContext(t)
+	create := func(config RFProfileDetail) func() error {
+		config.Name = "rf-profile"
+		return func() error { return profiles.CreateRFProfile(ctx, &config) }
+	}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		contains string
+	}{
+		{"GetEmptyName", func() error { _, err := profiles.GetRFProfile(ctx, ""); return err }, "cannot be empty"},
+		{"CreateNil", func() error { return profiles.CreateRFProfile(ctx, nil) }, "cannot be nil"},
+		{"CreateLongName", func() error {
+			return profiles.CreateRFProfile(ctx, &RFProfileDetail{Name: strings.Repeat("r", MaxRFProfileNameLength+1)})
+		}, "invalid profile name"},
+		{"UpdateNil", func() error { return profiles.UpdateRFProfile(ctx, nil) }, "cannot be nil"},
+		{"DeleteBlankName", func() error { return profiles.DeleteRFProfile(ctx, "  ") }, "invalid profile name"},
+		{"EmptyDCAChannels", func() error { return profiles.SetDCAChannels(ctx, "rf-profile", nil) }, "cannot be empty"},
+		{"MissingBand", create(RFProfileDetail{}), "unsupported band"},
+		{"UnknownBand", create(RFProfileDetail{Band: "dot11-60-ghz-band"}), "unsupported band"},
+		{"DSSSRateOn5GHz", create(RFProfileDetail{Band: RFBand5GHz, DataRate11M: "supported"}),
+			"11 Mbps data rate is not supported"},
+		{"DSSSRateOn6GHz", create(RFProfileDetail{Band: RFBand6GHz, DataRate1M: "mandatory"}),
+			"1 Mbps data rate is not supported"},
+		{"TxPowerOutOfRange", create(RFProfileDetail{Band: RFBand5GHz, TxPowerMax: dBm(31)}), "maximum TX power"},
+		{"TxPowerMinAboveMax", create(RFProfileDetail{Band: RFBand5GHz, TxPowerMin: dBm(20), TxPowerMax: dBm(10)}),
+			"exceeds maximum TX power"},
+		{"CoverageRSSIPositive", create(RFProfileDetail{Band: RFBand24GHz, CoverageDataPacketRSSIThreshold: 10}),
+			"data packet coverage RSSI"},
+		{"MaxClientsOutOfRange", create(RFProfileDetail{Band: RFBand5GHz, MaxClients: MaxClientsPerRadio + 1}),
+			"maximum clients"},
+		{"5GHzChannelOn24GHz", create(RFProfileDetail{Band: RFBand24GHz,
+			RfdcaRemovedChannels: &RfdcaRemovedChannels{RfdcaRemovedChannel: []RfdcaRemovedChannel{{Channel: 36}}}}),
+			"DCA channel 36"},
+		{"UnalignedChannelOn6GHz", create(RFProfileDetail{Band: RFBand6GHz,
+			RfdcaRemovedChannels: &RfdcaRemovedChannels{RfdcaRemovedChannel: []RfdcaRemovedChannel{{Channel: 3}}}}),
+			"DCA channel 3"},
+		{"WideChannelOn24GHz", create(RFProfileDetail{Band: RFBand24GHz, ChannelWidthMax: "width-40-mhz"}),
+			"channel width 40 MHz"},
+		{"BandSelectOn5GHz", create(RFProfileDetail{Band: RFBand5GHz, BandSelectProbeResponse: true}),
+			"band select is only supported"},
+		{"BandSelectRSSIOutOfRange", create(RFProfileDetail{Band: RFBand24GHz, BandSelectClientRSSI: -95}),
+			"band select client RSSI"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("Expected error containing %q, got %v", tt.contains, err)
+			}
+		})
+	}
+
+	valid := []RFProfileDetail{
+		{Band: RFBand24GHz, DataRate1M: "mandatory", BandSelectCycleCount: 2, BandSelectClientRSSI: -80},
+		{Band: RFBand5GHz, ChannelWidthMax: "best", CoverageVoicePacketRSSIThreshold: -80},
+		{Band: RFBand6GHz, TxPowerMin: dBm(-10), TxPowerMax: dBm(30), ChannelWidthMax: "width-160-mhz"},
+	}
+	for _, config := range valid {
+		if err := validateRFProfile(&config, config.Band); err != nil {
+			t.Errorf("Expected valid %s profile, got %v", config.Band, err)
+		}
+	}
+}
+
+// dBm returns a pointer to a TX power limit.
+func dBm(power int) *int {
+	return &power
+}
@@ -9,3 +9,13 @@ type CiscoIOSXEWirelessRFCfgRFTagPayload struct {
 type CiscoIOSXEWirelessRFCfgRFTagsPayload struct {
 	RFTag RFTag `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-tag"`
 }
+
+// CiscoIOSXEWirelessRFCfgRFProfilePayload represents individual RF profile response structure.
+type CiscoIOSXEWirelessRFCfgRFProfilePayload struct {
+	RFProfiles []RFProfileDetail `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-profile"`
+}
+
+// CiscoIOSXEWirelessRFCfgRFProfilesPayload represents request structure for rf-profiles endpoint.
+type CiscoIOSXEWirelessRFCfgRFProfilesPayload struct {
+	RFProfile RFProfileDetail `json:"Cisco-IOS-XE-wireless-rf-cfg:rf-profile"`
+}
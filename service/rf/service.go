@@ -23,6 +23,11 @@ func (s Service) RFTag() *RFTagService {
 	return NewRFTagService(s.Client())
 }
 
+// RFProfile returns an RF profile service instance for RF profile management operations.
+func (s Service) RFProfile() *RFProfileService {
+	return NewRFProfileService(s.Client())
+}
+
 // GetConfig retrieves RF configuration data including RF profiles and power settings.
 func (s Service) GetConfig(ctx context.Context) (*CiscoIOSXEWirelessRFCfg, error) {
 	return core.Get[CiscoIOSXEWirelessRFCfg](ctx, s.Client(), routes.RFCfgPath)
@@ -178,7 +178,7 @@ func (s *RFTagService) validateTagName(tagName string) error {
 
 // buildTagURL builds URL for specific tag operations using RESTCONF builder.
 func (s *RFTagService) buildTagURL(tagName string) string {
-	return fmt.Sprintf("%s/rf-tag=%s", routes.RFTagsPath, tagName)
+	return s.Client().RESTCONFBuilder().BuildQueryURL(routes.RFTagByNamePath, tagName)
 }
 
 // buildPayload builds a payload for tag operations using the request.
@@ -541,3 +541,26 @@ func TestRfTagServiceUnit_ErrorHandling_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestRfTagServiceUnit_GetRFTag_EscapedName(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller recording the request path exactly as sent on the wire
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponse("Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-tags/rf-tag=site/floor 1,east",
+			`{"Cisco-IOS-XE-wireless-rf-cfg:rf-tag":[{"tag-name":"site/floor 1,east"}]}`),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+	rfTagService := NewRFTagService(testutil.NewTestClient(server).Core().(*core.Client))
+
+	tag, err := rfTagService.GetRFTag(testutil.TestContext(t), "site/floor 1,east")
+	if err != nil || tag == nil || tag.TagName != "site/floor 1,east" {
+		t.Fatalf("GetRFTag failed: %v, %+v", err, tag)
+	}
+	requests := recorder.Requests()
+	if len(requests) != 1 || !strings.HasSuffix(requests[0].EscapedPath, "/rf-tag=site%2Ffloor%201%2Ceast") {
+		t.Errorf("Expected percent-encoded tag name in request path, got %+v", requests)
+	}
+}
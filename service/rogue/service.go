@@ -2,6 +2,7 @@ package rogue
 
 import (
 	"context"
+	"iter"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
@@ -28,6 +29,12 @@ func (s Service) ListRogues(ctx context.Context) (*CiscoIOSXEWirelessRogueData,
 	return core.Get[CiscoIOSXEWirelessRogueData](ctx, s.Client(), routes.RogueDataPath)
 }
 
+// StreamRogues yields rogue access point entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListRogues on large controllers.
+func (s Service) StreamRogues(ctx context.Context, opts ...core.QueryOption) iter.Seq2[RogueData, error] {
+	return core.Stream[RogueData](ctx, s.Client(), routes.RogueDataPath, opts...)
+}
+
 // GetRogueByMAC retrieves rogue data filtered by rogue address.
 func (s Service) GetRogueByMAC(ctx context.Context, mac string) (*CiscoIOSXEWirelessRogueData, error) {
 	if mac == "" {
@@ -43,6 +50,12 @@ func (s Service) ListRogueClients(ctx context.Context) (*CiscoIOSXEWirelessRogue
 	return core.Get[CiscoIOSXEWirelessRogueClientData](ctx, s.Client(), routes.RogueClientDataPath)
 }
 
+// StreamRogueClients yields rogue client entries one at a time without buffering the whole response.
+// It is the memory-efficient alternative to ListRogueClients on large controllers.
+func (s Service) StreamRogueClients(ctx context.Context, opts ...core.QueryOption) iter.Seq2[RogueClientData, error] {
+	return core.Stream[RogueClientData](ctx, s.Client(), routes.RogueClientDataPath, opts...)
+}
+
 // GetRogueClientByMAC retrieves rogue data filtered by rogue address.
 func (s Service) GetRogueClientByMAC(ctx context.Context, mac string) (*CiscoIOSXEWirelessRogueClientData, error) {
 	if mac == "" {
@@ -158,6 +158,18 @@ func TestRogueServiceUnit_ListOperations_MockSuccess(t *testing.T) {
 		return
 	}
 
+	// Test StreamRogues yields the same entries as ListRogues
+	streamed := 0
+	for _, err := range service.StreamRogues(ctx) {
+		if err != nil {
+			t.Fatalf("StreamRogues failed: %v", err)
+		}
+		streamed++
+	}
+	if streamed != len(rogues.RogueData) {
+		t.Errorf("Expected %d entries from StreamRogues, got %d", len(rogues.RogueData), streamed)
+	}
+
 	// Test ListRogueClients
 	clients, err := service.ListRogueClients(ctx)
 	if err != nil {
@@ -0,0 +1,386 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"strings"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/service"
+)
+
+// AP join profile limits enforced before write operations.
+const (
+	MaxAPJoinProfileNameLength  = 32    // Maximum AP join profile name length
+	MinManagementPasswordLength = 8     // Minimum AP management password length
+	MaxManagementPasswordLength = 120   // Maximum AP management password length
+	MaxHeartBeatTimeout         = 30    // Maximum CAPWAP heartbeat timeout in seconds
+	MaxDiscoveryTimeout         = 10    // Maximum CAPWAP discovery timeout in seconds
+	MaxFastHeartBeatTimeout     = 10    // Maximum CAPWAP fast heartbeat timeout in seconds
+	MinPrimaryDiscoveryTimeout  = 30    // Minimum CAPWAP primary discovery timeout in seconds
+	MaxPrimaryDiscoveryTimeout  = 3600  // Maximum CAPWAP primary discovery timeout in seconds
+	MinPrimedJoinTimeout        = 120   // Minimum CAPWAP primed join timeout in seconds
+	MaxPrimedJoinTimeout        = 43200 // Maximum CAPWAP primed join timeout in seconds
+)
+
+// APJoinProfileService provides AP join profile management operations.
+type APJoinProfileService struct {
+	service.BaseService
+}
+
+// NewAPJoinProfileService creates a new AP join profile service.
+func NewAPJoinProfileService(client *core.Client) *APJoinProfileService {
+	return &APJoinProfileService{
+		BaseService: service.NewBaseService(client),
+	}
+}
+
+// GetAPJoinProfile retrieves a specific AP join profile configuration.
+func (s *APJoinProfileService) GetAPJoinProfile(ctx context.Context, profileName string) (*ApCfgProfile, error) {
+	if err := s.validateProfileName(profileName); err != nil {
+		return nil, err
+	}
+
+	result, err := core.Get[CiscoIOSXEWirelessSiteCfgApCfgProfile](ctx, s.Client(), s.buildProfileURL(profileName))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.ApCfgProfile) == 0 {
+		return nil, nil
+	}
+
+	return &result.ApCfgProfile[0], nil
+}
+
+// ListAPJoinProfiles retrieves all AP join profile configurations.
+func (s *APJoinProfileService) ListAPJoinProfiles(ctx context.Context) ([]ApCfgProfile, error) {
+	result, err := NewService(s.Client()).ListAPProfileConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.ApCfgProfiles.ApCfgProfile) == 0 {
+		return []ApCfgProfile{}, nil
+	}
+
+	return result.ApCfgProfiles.ApCfgProfile, nil
+}
+
+// CreateAPJoinProfile creates a new AP join profile configuration. Pointer fields are sent as set,
+// while false, zero and empty settings of the other fields are not sent, so the controller applies
+// its defaults to them.
+func (s *APJoinProfileService) CreateAPJoinProfile(ctx context.Context, config *ApCfgProfile) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+	if err := s.validateProfileName(config.ProfileName); err != nil {
+		return err
+	}
+
+	payload, err := buildSparsePayload(config)
+	if err != nil {
+		return err
+	}
+	return core.PostVoid(ctx, s.Client(), routes.APProfilesPath, payload)
+}
+
+// UpdateAPJoinProfile merges the settings of config into an existing AP join profile using PATCH
+// operation. Pointer fields are sent as set, including false, zero and empty values, and nil
+// pointers keep their current values. The other fields are sent without their false, zero and
+// empty settings, so DataEncryptionFlag, PublicIPDiscovery and the members of the non-pointer
+// containers such as JumboMtu, DeviceMgmt, Tunnel and RogueDetection cannot be turned off or
+// cleared by this method.
+func (s *APJoinProfileService) UpdateAPJoinProfile(ctx context.Context, config *ApCfgProfile) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+	if err := s.validateProfileName(config.ProfileName); err != nil {
+		return err
+	}
+
+	payload, err := buildSparsePayload(config)
+	if err != nil {
+		return err
+	}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(config.ProfileName), payload)
+}
+
+// DeleteAPJoinProfile deletes an AP join profile configuration.
+func (s *APJoinProfileService) DeleteAPJoinProfile(ctx context.Context, profileName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.Delete(ctx, s.Client(), s.buildProfileURL(profileName))
+}
+
+// SetCAPWAPTimers sets the CAPWAP timers of an AP join profile. Timers given as 0 are unchanged.
+func (s *APJoinProfileService) SetCAPWAPTimers(ctx context.Context, profileName string, timers CAPWAPTimer) error {
+	checks := []struct {
+		name              string
+		value, minV, maxV int
+	}{
+		{"heartbeat timeout", timers.HeartBeatTimeout, 1, MaxHeartBeatTimeout},
+		{"discovery timeout", timers.DiscoveryTimeout, 1, MaxDiscoveryTimeout},
+		{"fast heartbeat timeout", timers.FastHeartBeatTimeout, 1, MaxFastHeartBeatTimeout},
+		{"primary discovery timeout", timers.PrimaryDiscoveryTimeout, MinPrimaryDiscoveryTimeout, MaxPrimaryDiscoveryTimeout},
+		{"primed join timeout", timers.PrimedJoinTimeout, MinPrimedJoinTimeout, MaxPrimedJoinTimeout},
+	}
+	for _, check := range checks {
+		if check.value != 0 && (check.value < check.minV || check.value > check.maxV) {
+			return fmt.Errorf("AP join profile validation failed: %w",
+				fmt.Errorf("%s range validation failed: %d not in %d-%d", check.name, check.value, check.minV, check.maxV))
+		}
+	}
+	if timers == (CAPWAPTimer{}) {
+		return errors.New("at least one CAPWAP timer must be set")
+	}
+
+	payload := struct {
+		Timer capwapTimerValues `json:"Cisco-IOS-XE-wireless-site-cfg:capwap-timer"`
+	}{Timer: capwapTimerValues(timers)}
+	return s.patchContainer(ctx, profileName, "capwap-timer", payload)
+}
+
+// SetManagementCredentials sets the username and password used to log in to the APs of an AP join
+// profile, and the enable secret unless it is empty. They are sent in clear text and encrypted by
+// the controller when password encryption is enabled.
+func (s *APJoinProfileService) SetManagementCredentials(
+	ctx context.Context, profileName, username, password, secret string,
+) error {
+	if username == "" {
+		return errors.New("management username cannot be empty")
+	}
+	if err := validateManagementPassword("password", password); err != nil {
+		return err
+	}
+	credentials := userMgmtValues{Username: username, Password: password, PasswordType: PasswordTypeClear}
+	if secret != "" {
+		if err := validateManagementPassword("secret", secret); err != nil {
+			return err
+		}
+		credentials.Secret = secret
+		credentials.SecretType = PasswordTypeClear
+	}
+
+	payload := struct {
+		UserMgmt userMgmtValues `json:"Cisco-IOS-XE-wireless-site-cfg:user-mgmt"`
+	}{UserMgmt: credentials}
+	return s.patchContainer(ctx, profileName, "user-mgmt", payload)
+}
+
+// SetSyslogHost sets the IPv4 or IPv6 address of the syslog server the APs of an AP join profile
+// log to.
+func (s *APJoinProfileService) SetSyslogHost(ctx context.Context, profileName, host string) error {
+	if err := validateIPAddress("syslog host", host); err != nil {
+		return err
+	}
+
+	payload := struct {
+		Syslog struct {
+			Host string `json:"host"`
+		} `json:"Cisco-IOS-XE-wireless-site-cfg:syslog"`
+	}{}
+	payload.Syslog.Host = host
+	return s.patchContainer(ctx, profileName, "syslog", payload)
+}
+
+// SetNTPServer sets the IPv4 or IPv6 address of the NTP server the APs of an AP join profile
+// synchronize with.
+func (s *APJoinProfileService) SetNTPServer(ctx context.Context, profileName, address string) error {
+	if err := validateIPAddress("NTP server", address); err != nil {
+		return err
+	}
+
+	payload := struct {
+		NtpServerInfo NtpServerInfo `json:"Cisco-IOS-XE-wireless-site-cfg:ntp-server-info"`
+	}{NtpServerInfo: NtpServerInfo{NtpAddress: address}}
+	return s.patchContainer(ctx, profileName, "ntp-server-info", payload)
+}
+
+// SetLEDState turns the status LEDs of the APs of an AP join profile on or off.
+func (s *APJoinProfileService) SetLEDState(ctx context.Context, profileName string, enabled bool) error {
+	payload := struct {
+		LedState LedState `json:"Cisco-IOS-XE-wireless-site-cfg:led-state"`
+	}{LedState: LedState{LedState: enabled}}
+	return s.patchContainer(ctx, profileName, "led-state", payload)
+}
+
+// SetDot1XSupplicant enables 802.1X authentication of the APs of an AP join profile towards their
+// switch port. The password is required unless EAP-TLS is used, and is sent in clear text.
+func (s *APJoinProfileService) SetDot1XSupplicant(ctx context.Context, profileName string, supplicant ApDot1X) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	switch supplicant.EAPType {
+	case Dot1XEAPTypeFAST, Dot1XEAPTypePEAP:
+		if supplicant.Username == "" || supplicant.Password == "" {
+			return fmt.Errorf("AP join profile validation failed: %w",
+				fmt.Errorf("802.1X username and password are required for '%s'", supplicant.EAPType))
+		}
+	case Dot1XEAPTypeTLS:
+		if supplicant.Username == "" {
+			return fmt.Errorf("AP join profile validation failed: %w",
+				errors.New("802.1X username is required for EAP-TLS"))
+		}
+	default:
+		return fmt.Errorf("AP join profile validation failed: %w",
+			fmt.Errorf("unsupported EAP type '%s': expected '%s', '%s' or '%s'",
+				supplicant.EAPType, Dot1XEAPTypeFAST, Dot1XEAPTypePEAP, Dot1XEAPTypeTLS))
+	}
+	if supplicant.Password != "" {
+		supplicant.PasswordType = PasswordTypeClear
+	}
+
+	payload := struct {
+		Dot1X ApDot1X `json:"Cisco-IOS-XE-wireless-site-cfg:dot1x"`
+	}{Dot1X: supplicant}
+	return core.PutVoid(ctx, s.Client(), s.buildProfileURL(profileName)+"/dot1x", payload)
+}
+
+// RemoveDot1XSupplicant disables 802.1X authentication of the APs of an AP join profile.
+func (s *APJoinProfileService) RemoveDot1XSupplicant(ctx context.Context, profileName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.Delete(ctx, s.Client(), s.buildProfileURL(profileName)+"/dot1x")
+}
+
+// patchContainer merges payload into a container of an AP join profile, leaving the settings not
+// modeled by this package untouched.
+func (s *APJoinProfileService) patchContainer(ctx context.Context, profileName, container string, payload any) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(profileName)+"/"+container, payload)
+}
+
+// validateProfileName validates AP join profile name.
+func (s *APJoinProfileService) validateProfileName(profileName string) error {
+	if profileName == "" {
+		return errors.New("AP join profile name cannot be empty")
+	}
+	if strings.TrimSpace(profileName) == "" || len(profileName) > MaxAPJoinProfileNameLength {
+		return fmt.Errorf("AP join profile validation failed: %w",
+			fmt.Errorf("invalid profile name format (max %d characters): '%s'", MaxAPJoinProfileNameLength, profileName))
+	}
+	return nil
+}
+
+// buildProfileURL builds URL for specific profile operations using RESTCONF builder.
+func (s *APJoinProfileService) buildProfileURL(profileName string) string {
+	return s.Client().RESTCONFBuilder().BuildQueryURL(routes.APCfgProfileQueryPath, profileName)
+}
+
+// capwapTimerValues is CAPWAPTimer with unchanged (zero) timers omitted.
+type capwapTimerValues struct {
+	FastHeartBeatTimeout    int `json:"fast-heart-beat-timeout,omitempty"`
+	HeartBeatTimeout        int `json:"heart-beat-timeout,omitempty"`
+	DiscoveryTimeout        int `json:"discovery-timeout,omitempty"`
+	PrimaryDiscoveryTimeout int `json:"primary-discovery-timeout,omitempty"`
+	PrimedJoinTimeout       int `json:"primed-join-timeout,omitempty"`
+}
+
+// userMgmtValues is UserMgmt without the enable secret when it is not changed.
+type userMgmtValues struct {
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	PasswordType string `json:"password-type"`
+	Secret       string `json:"secret,omitempty"`
+	SecretType   string `json:"secret-type,omitempty"`
+}
+
+// apJoinProfilePointerFields holds the JSON names of the pointer fields of ApCfgProfile.
+var apJoinProfilePointerFields = pointerFieldNames(reflect.TypeFor[ApCfgProfile]())
+
+// buildSparsePayload wraps an AP join profile for the ap-cfg-profiles endpoint without the zero
+// values of its non-pointer fields. ApCfgProfile always encodes them, and empty enumerations or
+// zero timers in them would be rejected by the controller. Pointer fields are set by the caller
+// and are sent unchanged.
+func buildSparsePayload(config *ApCfgProfile) (map[string]map[string]any, error) {
+	data, err := json.Marshal(CiscoIOSXEWirelessApCfgProfilesPayload{ApCfgProfile: *config})
+	if err != nil {
+		return nil, fmt.Errorf("AP join profile payload encoding failed: %w", err)
+	}
+	var payload map[string]map[string]any
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("AP join profile payload encoding failed: %w", err)
+	}
+	for _, profile := range payload {
+		removeZeroValues(profile, apJoinProfilePointerFields)
+	}
+	return payload, nil
+}
+
+// removeZeroValues deletes false, zero, empty and null members from a decoded JSON object,
+// including nested objects left empty. Members named in keep are left as they are.
+func removeZeroValues(object map[string]any, keep map[string]bool) {
+	for key, value := range object {
+		if keep[key] {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			removeZeroValues(v, nil)
+			if len(v) == 0 {
+				delete(object, key)
+			}
+		case []any:
+			if len(v) == 0 {
+				delete(object, key)
+			}
+		case bool:
+			if !v {
+				delete(object, key)
+			}
+		case float64:
+			if v == 0 {
+				delete(object, key)
+			}
+		case string:
+			if v == "" {
+				delete(object, key)
+			}
+		case nil:
+			delete(object, key)
+		}
+	}
+}
+
+// pointerFieldNames returns the JSON names of the pointer fields of struct type t.
+func pointerFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Type.Kind() == reflect.Pointer {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// validateManagementPassword validates the length of an AP management password or secret.
+func validateManagementPassword(name, password string) error {
+	if len(password) < MinManagementPasswordLength || len(password) > MaxManagementPasswordLength {
+		return fmt.Errorf("AP join profile validation failed: %w",
+			fmt.Errorf("management %s length validation failed: must be %d-%d characters",
+				name, MinManagementPasswordLength, MaxManagementPasswordLength))
+	}
+	return nil
+}
+
+// validateIPAddress validates an IPv4 or IPv6 address.
+func validateIPAddress(name, address string) error {
+	if address == "" {
+		return fmt.Errorf("%s cannot be empty", name)
+	}
+	if _, err := netip.ParseAddr(address); err != nil {
+		return fmt.Errorf("AP join profile validation failed: %w",
+			fmt.Errorf("invalid %s address '%s': %w", name, address, err))
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package site
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/pkg/testutil"
+)
+
+func TestAPJoinProfileServiceUnit_Constructor_Success(t *testing.T) {
+	service := NewService(nil)
+	if service.APJoinProfile() == nil {
+		t.Error("Expected APJoinProfile service, got nil")
+	}
+}
+
+func TestAPJoinProfileServiceUnit_Operations_MockSuccess(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller serving one AP join profile and recording write requests
+	profilesPath := "Cisco-IOS-XE-wireless-site-cfg:site-cfg-data/ap-cfg-profiles"
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponses(map[string]string{
+			profilesPath: `{"Cisco-IOS-XE-wireless-site-cfg:ap-cfg-profiles":{"ap-cfg-profile":[` +
+				`{"profile-name":"default-ap-profile"},{"profile-name":"branch"}]}}`,
+			profilesPath + "/ap-cfg-profile=branch": `{"Cisco-IOS-XE-wireless-site-cfg:ap-cfg-profile":[` +
+				`{"profile-name":"branch","capwap-timer":{"fast-heart-beat-timeout":5,"heart-beat-timeout":30},` +
+				`"ntp-server-info":{"ntp-address":"192.0.2.123"}}]}`,
+		}),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+
+	client := testutil.NewTestClient(server).Core().(*core.Client)
+	profiles := NewAPJoinProfileService(client)
+	ctx := testutil.TestContext(t)
+
+	profile, err := profiles.GetAPJoinProfile(ctx, "branch")
+	if err != nil || profile == nil {
+		t.Fatalf("GetAPJoinProfile failed: %v, %+v", err, profile)
+	}
+	if profile.CAPWAPTimer.HeartBeatTimeout != 30 || profile.NtpServerInfo.NtpAddress != "192.0.2.123" {
+		t.Errorf("Unexpected AP join profile: %+v", profile)
+	}
+	list, err := profiles.ListAPJoinProfiles(ctx)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListAPJoinProfiles failed: %v, %+v", err, list)
+	}
+
+	description := "Branch APs"
+	steps := []struct {
+		name string
+		call func() error
+	}{
+		{"CreateAPJoinProfile", func() error {
+			return profiles.CreateAPJoinProfile(ctx, &ApCfgProfile{ProfileName: "branch", Description: &description})
+		}},
+		{"UpdateAPJoinProfile", func() error {
+			return profiles.UpdateAPJoinProfile(ctx, &ApCfgProfile{
+				ProfileName: "branch", DeviceMgmt: DeviceMgmt{SSH: true}, LedState: &LedState{LedState: false},
+			})
+		}},
+		{"SetCAPWAPTimers", func() error {
+			return profiles.SetCAPWAPTimers(ctx, "branch", CAPWAPTimer{HeartBeatTimeout: 15, PrimedJoinTimeout: 600})
+		}},
+		{"SetManagementCredentials", func() error {
+			return profiles.SetManagementCredentials(ctx, "branch", "apadmin", "Secr3tPass", "")
+		}},
+		{"SetSyslogHost", func() error { return profiles.SetSyslogHost(ctx, "branch", "2001:db8::514") }},
+		{"SetNTPServer", func() error { return profiles.SetNTPServer(ctx, "branch", "192.0.2.1") }},
+		{"SetLEDState", func() error { return profiles.SetLEDState(ctx, "branch", false) }},
+		{"SetDot1XSupplicant", func() error {
+			return profiles.SetDot1XSupplicant(ctx, "branch",
+				ApDot1X{Username: "ap-supplicant", Password: "Dot1xPass", EAPType: Dot1XEAPTypePEAP})
+		}},
+		{"RemoveDot1XSupplicant", func() error { return profiles.RemoveDot1XSupplicant(ctx, "branch") }},
+		{"DeleteAPJoinProfile", func() error { return profiles.DeleteAPJoinProfile(ctx, "branch") }},
+	}
+	for _, step := range steps {
+		if err := step.call(); err != nil {
+			t.Fatalf("%s failed: %v", step.name, err)
+		}
+	}
+
+	postPath := "/restconf/data/" + profilesPath
+	profilePath := postPath + "/ap-cfg-profile=branch"
+	expected := []struct{ method, path, body string }{
+		{http.MethodPost, postPath,
+			`{"Cisco-IOS-XE-wireless-site-cfg:ap-cfg-profile":{"description":"Branch APs","profile-name":"branch"}}`},
+		{http.MethodPatch, profilePath, `{"Cisco-IOS-XE-wireless-site-cfg:ap-cfg-profile":` +
+			`{"device-mgmt":{"ssh":true},"led-state":{"led-state":false},"profile-name":"branch"}}`},
+		{http.MethodPatch, profilePath + "/capwap-timer",
+			`{"Cisco-IOS-XE-wireless-site-cfg:capwap-timer":{"heart-beat-timeout":15,"primed-join-timeout":600}}`},
+		{http.MethodPatch, profilePath + "/user-mgmt", `{"Cisco-IOS-XE-wireless-site-cfg:user-mgmt":` +
+			`{"username":"apadmin","password":"Secr3tPass","password-type":"clear"}}`},
+		{http.MethodPatch, profilePath + "/syslog", `{"Cisco-IOS-XE-wireless-site-cfg:syslog":{"host":"2001:db8::514"}}`},
+		{http.MethodPatch, profilePath + "/ntp-server-info",
+			`{"Cisco-IOS-XE-wireless-site-cfg:ntp-server-info":{"ntp-address":"192.0.2.1"}}`},
+		{http.MethodPatch, profilePath + "/led-state", `{"Cisco-IOS-XE-wireless-site-cfg:led-state":{"led-state":false}}`},
+		{http.MethodPut, profilePath + "/dot1x", `{"Cisco-IOS-XE-wireless-site-cfg:dot1x":{"username":"ap-supplicant",` +
+			`"password":"Dot1xPass","password-type":"clear","eap-type":"eap-peap"}}`},
+		{http.MethodDelete, profilePath + "/dot1x", ""},
+		{http.MethodDelete, profilePath, ""},
+	}
+	writes := recorder.Writes()
+	if len(writes) != len(expected) {
+		t.Fatalf("Expected %d write requests, got %d", len(expected), len(writes))
+	}
+	for i, want := range expected {
+		got := writes[i]
+		if got.Method != want.method || got.Path != want.path || got.Body != want.body {
+			t.Errorf("Request %d: expected %s %s %s, got %s %s %s",
+				i, want.method, want.path, want.body, got.Method, got.Path, got.Body)
+		}
+	}
+}
+
+func TestAPJoinProfileServiceUnit_ValidationErrors(t *testing.T) {
+	profiles := NewAPJoinProfileService(nil)
+	ctx := testutil.TestContext(t)
+	longName := strings.Repeat("a", MaxAPJoinProfileNameLength+1)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"GetEmptyName", func() error { _, err := profiles.GetAPJoinProfile(ctx, ""); return err }},
+		{"CreateNil", func() error { return profiles.CreateAPJoinProfile(ctx, nil) }},
+		{"CreateLongName", func() error { return profiles.CreateAPJoinProfile(ctx, &ApCfgProfile{ProfileName: longName}) }},
+		{"UpdateNil", func() error { return profiles.UpdateAPJoinProfile(ctx, nil) }},
+		{"DeleteBlankName", func() error { return profiles.DeleteAPJoinProfile(ctx, " ") }},
+		{"NoCAPWAPTimers", func() error { return profiles.SetCAPWAPTimers(ctx, "branch", CAPWAPTimer{}) }},
+		{"HeartBeatTimeoutOutOfRange", func() error {
+			return profiles.SetCAPWAPTimers(ctx, "branch", CAPWAPTimer{HeartBeatTimeout: MaxHeartBeatTimeout + 1})
+		}},
+		{"PrimedJoinTimeoutTooShort", func() error {
+			return profiles.SetCAPWAPTimers(ctx, "branch", CAPWAPTimer{PrimedJoinTimeout: MinPrimedJoinTimeout - 1})
+		}},
+		{"TimersEmptyProfileName", func() error {
+			return profiles.SetCAPWAPTimers(ctx, "", CAPWAPTimer{HeartBeatTimeout: 10})
+		}},
+		{"EmptyUsername", func() error { return profiles.SetManagementCredentials(ctx, "branch", "", "Secr3tPass", "") }},
+		{"ShortPassword", func() error { return profiles.SetManagementCredentials(ctx, "branch", "admin", "short", "") }},
+		{"ShortSecret", func() error {
+			return profiles.SetManagementCredentials(ctx, "branch", "admin", "Secr3tPass", "short")
+		}},
+		{"EmptySyslogHost", func() error { return profiles.SetSyslogHost(ctx, "branch", "") }},
+		{"SyslogHostname", func() error { return profiles.SetSyslogHost(ctx, "branch", "syslog.example.com") }},
+		{"InvalidNTPServer", func() error { return profiles.SetNTPServer(ctx, "branch", "192.0.2.300") }},
+		{"LEDEmptyProfileName", func() error { return profiles.SetLEDState(ctx, "", true) }},
+		{"Dot1XUnknownEAPType", func() error {
+			return profiles.SetDot1XSupplicant(ctx, "branch", ApDot1X{Username: "ap", Password: "pass", EAPType: "md5"})
+		}},
+		{"Dot1XPEAPWithoutPassword", func() error {
+			return profiles.SetDot1XSupplicant(ctx, "branch", ApDot1X{Username: "ap", EAPType: Dot1XEAPTypePEAP})
+		}},
+		{"Dot1XTLSWithoutUsername", func() error {
+			return profiles.SetDot1XSupplicant(ctx, "branch", ApDot1X{EAPType: Dot1XEAPTypeTLS})
+		}},
+		{"RemoveDot1XEmptyProfileName", func() error { return profiles.RemoveDot1XSupplicant(ctx, "") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err == nil {
+				t.Errorf("Expected validation error for %s", tt.name)
+			}
+		})
+	}
+}
@@ -18,6 +18,11 @@ type CiscoIOSXEWirelessSiteCfgSiteTagConfigs struct {
 	SiteTagConfigs SiteTagConfigs `json:"Cisco-IOS-XE-wireless-site-cfg:site-tag-configs"` // Site tag configs list (Live: IOS-XE 17.12.6a)
 }
 
+// CiscoIOSXEWirelessSiteCfgApCfgProfile represents AP config profile wrapper (Live: IOS-XE 17.12.6a).
+type CiscoIOSXEWirelessSiteCfgApCfgProfile struct {
+	ApCfgProfile []ApCfgProfile `json:"Cisco-IOS-XE-wireless-site-cfg:ap-cfg-profile"` // AP config profile entries (Live: IOS-XE 17.12.6a)
+}
+
 // CiscoIOSXEWirelessSiteCfgSiteTagConfig represents site tag config wrapper (Live: IOS-XE 17.12.6a).
 type CiscoIOSXEWirelessSiteCfgSiteTagConfig struct {
 	SiteListEntry []SiteListEntry `json:"Cisco-IOS-XE-wireless-site-cfg:site-tag-config"` // Site tag config entries (Live: IOS-XE 17.12.6a)
@@ -67,6 +72,7 @@ type ApCfgProfile struct {
 	ApTzConfig         ApTzConfig        `json:"ap-tz-config"`                  // AP timezone config (Live: IOS-XE 17.12.6a)
 	RadioStatsMonitor  RadioStatsMonitor `json:"radio-stats-monitor"`           // AP radio statistics monitoring config (Live: IOS-XE 17.12.6a)
 	ApProfPpCfg        ApProfPpCfg       `json:"ap-prof-pp-cfg"`                // Power profile config per AP profile (Live: IOS-XE 17.12.6a)
+	Dot1X              *ApDot1X          `json:"dot1x,omitempty"`               // AP 802.1X supplicant config (YANG: IOS-XE 17.12.1)
 }
 
 // SiteListEntry represents site list entry (Live: IOS-XE 17.12.6a).
@@ -115,7 +121,11 @@ type Tunnel struct {
 
 // CAPWAPTimer represents CAPWAP timer config (Live: IOS-XE 17.12.6a).
 type CAPWAPTimer struct {
-	FastHeartBeatTimeout int `json:"fast-heart-beat-timeout"` // Fast heartbeat timeout in seconds (Live: IOS-XE 17.12.6a)
+	FastHeartBeatTimeout    int `json:"fast-heart-beat-timeout"`             // Fast heartbeat timeout in seconds (Live: IOS-XE 17.12.6a)
+	HeartBeatTimeout        int `json:"heart-beat-timeout,omitempty"`        // Heartbeat timeout in seconds (YANG: IOS-XE 17.12.1)
+	DiscoveryTimeout        int `json:"discovery-timeout,omitempty"`         // Discovery timeout in seconds (YANG: IOS-XE 17.12.1)
+	PrimaryDiscoveryTimeout int `json:"primary-discovery-timeout,omitempty"` // Primary discovery timeout in seconds (YANG: IOS-XE 17.12.1)
+	PrimedJoinTimeout       int `json:"primed-join-timeout,omitempty"`       // Primed join timeout in seconds (YANG: IOS-XE 17.12.1)
 }
 
 // Syslog represents syslog config (Live: IOS-XE 17.12.6a).
@@ -207,3 +217,24 @@ type Poe struct {
 	PowerInjectorState          bool   `json:"power-injector-state"`           // Power injector state flag (YANG: IOS-XE 17.12.1)
 	PowerInjectorSelection      string `json:"power-injector-selection"`       // Power injector selection type (YANG: IOS-XE 17.12.1)
 }
+
+// ApDot1X represents AP 802.1X supplicant config (YANG: IOS-XE 17.12.1).
+type ApDot1X struct {
+	Username     string `json:"username,omitempty"`      // Supplicant username (YANG: IOS-XE 17.12.1)
+	Password     string `json:"password,omitempty"`      // Supplicant password (YANG: IOS-XE 17.12.1)
+	PasswordType string `json:"password-type,omitempty"` // Password encryption type (YANG: IOS-XE 17.12.1)
+	EAPType      string `json:"eap-type,omitempty"`      // EAP method of the supplicant (YANG: IOS-XE 17.12.1)
+}
+
+// Password encryption types of UserMgmt and ApDot1X.
+const (
+	PasswordTypeClear = "clear" // Password is given in clear text
+	PasswordTypeAES   = "aes"   // Password is AES encrypted by the controller
+)
+
+// EAP methods of ApDot1X.EAPType.
+const (
+	Dot1XEAPTypeFAST = "eap-fast" // EAP-FAST with username and password
+	Dot1XEAPTypePEAP = "eap-peap" // PEAP with username and password
+	Dot1XEAPTypeTLS  = "eap-tls"  // EAP-TLS with the AP certificate
+)
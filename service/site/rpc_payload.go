@@ -4,3 +4,8 @@ package site
 type CiscoIOSXEWirelessSiteTagConfigsPayload struct {
 	SiteListEntry SiteListEntry `json:"Cisco-IOS-XE-wireless-site-cfg:site-tag-config"`
 }
+
+// CiscoIOSXEWirelessApCfgProfilesPayload represents request structure for ap-cfg-profiles endpoint.
+type CiscoIOSXEWirelessApCfgProfilesPayload struct {
+	ApCfgProfile ApCfgProfile `json:"Cisco-IOS-XE-wireless-site-cfg:ap-cfg-profile"`
+}
@@ -23,6 +23,11 @@ func (s Service) SiteTag() *SiteTagService {
 	return NewSiteTagService(s.Client())
 }
 
+// APJoinProfile returns an AP join profile service instance for AP join profile management operations.
+func (s Service) APJoinProfile() *APJoinProfileService {
+	return NewAPJoinProfileService(s.Client())
+}
+
 // GetConfig retrieves site configuration data including AP configuration profiles and site tag configurations.
 func (s Service) GetConfig(ctx context.Context) (*CiscoIOSXEWirelessSiteCfg, error) {
 	return core.Get[CiscoIOSXEWirelessSiteCfg](ctx, s.Client(), routes.SiteCfgPath)
@@ -9,6 +9,7 @@ import (
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/service"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/service/flex"
 )
 
 // SiteTagService provides site tag management operations.
@@ -93,7 +94,7 @@ func (s *SiteTagService) SetAPJoinProfile(ctx context.Context, siteTagName, apJo
 	return s.setSiteTag(ctx, config)
 }
 
-// SetFlexProfile sets the flex profile for a site tag.
+// SetFlexProfile sets the flex profile for a site tag. The flex profile must exist.
 func (s *SiteTagService) SetFlexProfile(ctx context.Context, siteTagName, flexProfile string) error {
 	config, err := s.GetSiteTag(ctx, siteTagName)
 	if err != nil {
@@ -106,6 +107,17 @@ func (s *SiteTagService) SetFlexProfile(ctx context.Context, siteTagName, flexPr
 			fmt.Errorf("tag '%s' not found in controller configuration", siteTagName))
 	}
 
+	// The controller answers 404 for a missing profile, which is reported as not found below
+	profile, err := flex.NewFlexProfileService(s.Client()).GetFlexProfile(ctx, flexProfile)
+	if err != nil && !core.IsNotFoundError(err) {
+		return fmt.Errorf("site tag operation failed: %w",
+			fmt.Errorf("flex profile retrieval failed for '%s': %w", flexProfile, err))
+	}
+	if profile == nil {
+		return fmt.Errorf("site tag operation failed: %w",
+			fmt.Errorf("flex profile '%s' not found in controller configuration", flexProfile))
+	}
+
 	isLocalSite := false // Explicitly set to false for flex-profile compatibility
 	config.IsLocalSite = &isLocalSite
 	config.FlexProfile = &flexProfile
@@ -95,6 +95,11 @@ func TestSiteTagServiceUnit_SetOperations_MockSuccess(t *testing.T) {
 				}]
 			}
 		}`,
+		"Cisco-IOS-XE-wireless-flex-cfg:flex-cfg-data/flex-policy-entries/flex-policy-entry=new-flex-profile": `{
+			"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entry": [{
+				"policy-name": "new-flex-profile"
+			}]
+		}`,
 	}
 
 	mockServer := testutil.NewMockServer(testutil.WithSuccessResponses(mockResponses))
@@ -432,6 +437,11 @@ func TestSiteTagServiceUnit_SetOperations_AdvancedScenarios(t *testing.T) {
 				}]
 			}
 		}`,
+		"Cisco-IOS-XE-wireless-flex-cfg:flex-cfg-data/flex-policy-entries/flex-policy-entry=new-flex-profile": `{
+			"Cisco-IOS-XE-wireless-flex-cfg:flex-policy-entry": [{
+				"policy-name": "new-flex-profile"
+			}]
+		}`,
 	}
 
 	mockServer := testutil.NewMockServer(testutil.WithSuccessResponses(mockResponses))
@@ -456,6 +466,14 @@ func TestSiteTagServiceUnit_SetOperations_AdvancedScenarios(t *testing.T) {
 		}
 	})
 
+	t.Run("SetFlexProfile_MissingProfile", func(t *testing.T) {
+		err := siteTagService.SetFlexProfile(ctx, "test-site", "missing-flex-profile")
+		want := "flex profile 'missing-flex-profile' not found in controller configuration"
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing %q, got %v", want, err)
+		}
+	})
+
 	t.Run("SetLocalSite_ExistingTag", func(t *testing.T) {
 		err := siteTagService.SetLocalSite(ctx, "test-site", true)
 		if err != nil {
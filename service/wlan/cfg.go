@@ -16,11 +16,26 @@ type CiscoIOSXEWirelessWlanCfgWlanCfgEntries struct {
 	WlanCfgEntries *WlanCfgEntries `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entries"`
 }
 
+// CiscoIOSXEWirelessWlanCfgWlanCfgEntry represents a single WLAN configuration entry retrieved by profile name.
+type CiscoIOSXEWirelessWlanCfgWlanCfgEntry struct {
+	WlanCfgEntry []WlanCfgEntry `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry"`
+}
+
+// CiscoIOSXEWirelessWlanCfgWlanPolicy represents a single WLAN policy profile retrieved by name.
+type CiscoIOSXEWirelessWlanCfgWlanPolicy struct {
+	WlanPolicy []WlanPolicy `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-policy"`
+}
+
 // CiscoIOSXEWirelessWlanCfgPolicyListEntries represents the policy list entries.
 type CiscoIOSXEWirelessWlanCfgPolicyListEntries struct {
 	PolicyListEntries *PolicyListEntries `json:"Cisco-IOS-XE-wireless-wlan-cfg:policy-list-entries"`
 }
 
+// CiscoIOSXEWirelessWlanCfgPolicyListEntry represents a single policy list entry retrieved by tag name.
+type CiscoIOSXEWirelessWlanCfgPolicyListEntry struct {
+	PolicyListEntry []PolicyListEntry `json:"Cisco-IOS-XE-wireless-wlan-cfg:policy-list-entry"`
+}
+
 // CiscoIOSXEWirelessWlanCfgWirelessAaaPolicyConfigs represents the wireless AAA policy configurations.
 type CiscoIOSXEWirelessWlanCfgWirelessAaaPolicyConfigs struct {
 	WirelessAaaPolicyConfigs *WirelessAaaPolicyConfigs `json:"Cisco-IOS-XE-wireless-wlan-cfg:wireless-aaa-policy-configs"`
@@ -81,6 +96,12 @@ type WlanCfgEntry struct {
 	WepKeyIndex            int                `json:"wep-key-index,omitempty"`              // WEP key index for Static WEP Authentication (Live: IOS-XE 17.12.6a)
 }
 
+// Pre-shared key encryption types of WlanCfgEntry.PSKType.
+const (
+	PSKTypeClear = "clear" // Key is given in clear text
+	PSKTypeAES   = "aes"   // Key is AES encrypted by the controller
+)
+
 // APFVapIDData represents virtual AP interface identification data.
 type APFVapIDData struct {
 	SSID       string `json:"ssid"`        // Service Set Identifier (Live: IOS-XE 17.12.6a)
@@ -131,6 +152,8 @@ type WlanSwitchingPolicy struct {
 // WlanTimeout represents WLAN timeout configuration.
 type WlanTimeout struct {
 	SessionTimeout int `json:"session-timeout,omitempty"` // Session timeout in seconds (Live: IOS-XE 17.12.6a)
+	IdleTimeout    int `json:"idle-timeout,omitempty"`    // Idle timeout in seconds (YANG: IOS-XE 17.12.1)
+	IdleThreshold  int `json:"idle-threshold,omitempty"`  // Idle threshold in bytes (YANG: IOS-XE 17.12.1)
 }
 
 // PerSsidQos represents per-SSID QoS configuration.
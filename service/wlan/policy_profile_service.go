@@ -0,0 +1,273 @@
+package wlan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/service"
+)
+
+// Policy profile limits enforced before write operations.
+const (
+	MaxPolicyProfileNameLength = 32     // Maximum policy profile name length
+	MinVLANID                  = 1      // Lowest VLAN ID accepted as interface
+	MaxVLANID                  = 4094   // Highest VLAN ID accepted as interface
+	MaxSessionTimeout          = 86400  // Maximum session timeout in seconds; 0 disables it
+	MinIdleTimeout             = 15     // Minimum idle timeout in seconds
+	MaxIdleTimeout             = 100000 // Maximum idle timeout in seconds
+)
+
+// Directions of the per-SSID QoS policies removed by RemoveQoSPolicy.
+const (
+	QoSDirectionIngress = "ingress" // Policy applied to traffic from clients
+	QoSDirectionEgress  = "egress"  // Policy applied to traffic to clients
+)
+
+// AVCFlowMonitors names the AVC flow monitors attached to a policy profile. An empty name
+// detaches the monitor of that direction.
+type AVCFlowMonitors struct {
+	IPv4Ingress string // IPv4 ingress flow monitor
+	IPv4Egress  string // IPv4 egress flow monitor
+	IPv6Ingress string // IPv6 ingress flow monitor
+	IPv6Egress  string // IPv6 egress flow monitor
+}
+
+// PolicyProfileService provides policy profile management operations.
+type PolicyProfileService struct {
+	service.BaseService
+}
+
+// NewPolicyProfileService creates a new PolicyProfileService instance.
+func NewPolicyProfileService(c *core.Client) *PolicyProfileService {
+	return &PolicyProfileService{
+		BaseService: service.NewBaseService(c),
+	}
+}
+
+// GetPolicyProfile retrieves a specific policy profile configuration.
+func (s *PolicyProfileService) GetPolicyProfile(ctx context.Context, profileName string) (*WlanPolicy, error) {
+	if err := s.validateProfileName(profileName); err != nil {
+		return nil, err
+	}
+
+	result, err := core.Get[CiscoIOSXEWirelessWlanCfgWlanPolicy](ctx, s.Client(), s.buildProfileURL(profileName))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.WlanPolicy) == 0 {
+		return nil, nil
+	}
+
+	return &result.WlanPolicy[0], nil
+}
+
+// ListPolicyProfiles retrieves all policy profile configurations.
+func (s *PolicyProfileService) ListPolicyProfiles(ctx context.Context) ([]WlanPolicy, error) {
+	result, err := NewService(s.Client()).ListWlanPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.WlanPolicies == nil {
+		return []WlanPolicy{}, nil
+	}
+
+	return result.WlanPolicies.WlanPolicy, nil
+}
+
+// CreatePolicyProfile creates a new policy profile configuration.
+func (s *PolicyProfileService) CreatePolicyProfile(ctx context.Context, config *WlanPolicy) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+	if err := s.validateProfileName(config.PolicyProfileName); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessWlanPolicyPayload{WlanPolicy: *config}
+	return core.PostVoid(ctx, s.Client(), routes.WLANWlanPoliciesPath, payload)
+}
+
+// ReplacePolicyProfile replaces a policy profile configuration using PUT operation.
+// Settings not present in config are reset to their defaults.
+func (s *PolicyProfileService) ReplacePolicyProfile(ctx context.Context, config *WlanPolicy) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+	if err := s.validateProfileName(config.PolicyProfileName); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessWlanPolicyPayload{WlanPolicy: *config}
+	return core.PutVoid(ctx, s.Client(), s.buildProfileURL(config.PolicyProfileName), payload)
+}
+
+// DeletePolicyProfile deletes a policy profile configuration.
+func (s *PolicyProfileService) DeletePolicyProfile(ctx context.Context, profileName string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.Delete(ctx, s.Client(), s.buildProfileURL(profileName))
+}
+
+// SetVLAN sets the VLAN of a policy profile, given as VLAN ID, VLAN name or VLAN group name.
+func (s *PolicyProfileService) SetVLAN(ctx context.Context, profileName, vlan string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	if vlan == "" {
+		return errors.New("VLAN cannot be empty")
+	}
+	if id, err := strconv.Atoi(vlan); err == nil && (id < MinVLANID || id > MaxVLANID) {
+		return fmt.Errorf("policy profile validation failed: %w",
+			fmt.Errorf("VLAN ID range validation failed: %d not in %d-%d", id, MinVLANID, MaxVLANID))
+	}
+
+	payload := CiscoIOSXEWirelessWlanPolicyPayload{
+		WlanPolicy: WlanPolicy{PolicyProfileName: profileName, InterfaceName: vlan},
+	}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(profileName), payload)
+}
+
+// SetSwitchingPolicy sets central or local (FlexConnect) switching, authentication, DHCP and
+// association of a policy profile. All flags are sent, so false disables the central function.
+func (s *PolicyProfileService) SetSwitchingPolicy(
+	ctx context.Context, profileName string, policy WlanSwitchingPolicy,
+) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+
+	payload := struct {
+		Policy switchingPolicyValues `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-switching-policy"`
+	}{Policy: switchingPolicyValues(policy)}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(profileName)+"/wlan-switching-policy", payload)
+}
+
+// SetTimeouts sets the session and idle timeouts of a policy profile in seconds.
+// A session timeout of 0 disables it.
+func (s *PolicyProfileService) SetTimeouts(
+	ctx context.Context, profileName string, sessionTimeout, idleTimeout int,
+) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	if sessionTimeout < 0 || sessionTimeout > MaxSessionTimeout {
+		return fmt.Errorf("policy profile validation failed: %w",
+			fmt.Errorf("session timeout range validation failed: %d not in 0-%d", sessionTimeout, MaxSessionTimeout))
+	}
+	if idleTimeout < MinIdleTimeout || idleTimeout > MaxIdleTimeout {
+		return fmt.Errorf("policy profile validation failed: %w",
+			fmt.Errorf("idle timeout range validation failed: %d not in %d-%d",
+				idleTimeout, MinIdleTimeout, MaxIdleTimeout))
+	}
+
+	payload := struct {
+		Timeout timeoutValues `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-timeout"`
+	}{Timeout: timeoutValues{SessionTimeout: sessionTimeout, IdleTimeout: idleTimeout}}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(profileName)+"/wlan-timeout", payload)
+}
+
+// SetQoSPolicies sets the per-SSID ingress and egress QoS policies of a policy profile using PATCH
+// operation. An empty name leaves the policy of that direction unchanged; use RemoveQoSPolicy to
+// remove it.
+func (s *PolicyProfileService) SetQoSPolicies(ctx context.Context, profileName, ingress, egress string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	if ingress == "" && egress == "" {
+		return errors.New("at least one QoS policy must be set")
+	}
+
+	payload := struct {
+		Qos PerSsidQos `json:"Cisco-IOS-XE-wireless-wlan-cfg:per-ssid-qos"`
+	}{Qos: PerSsidQos{IngressServiceName: ingress, EgressServiceName: egress}}
+	return core.PatchVoid(ctx, s.Client(), s.buildProfileURL(profileName)+"/per-ssid-qos", payload)
+}
+
+// RemoveQoSPolicy removes the per-SSID QoS policy of direction, QoSDirectionIngress or
+// QoSDirectionEgress, from a policy profile, leaving the other direction untouched.
+func (s *PolicyProfileService) RemoveQoSPolicy(ctx context.Context, profileName, direction string) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+	if direction != QoSDirectionIngress && direction != QoSDirectionEgress {
+		return fmt.Errorf("policy profile validation failed: %w",
+			fmt.Errorf("unsupported QoS direction '%s': expected '%s' or '%s'",
+				direction, QoSDirectionIngress, QoSDirectionEgress))
+	}
+	return core.Delete(ctx, s.Client(), s.buildProfileURL(profileName)+"/per-ssid-qos/"+direction+"-service-name")
+}
+
+// SetAVCFlowMonitors attaches AVC flow monitors to a policy profile. The four directions are
+// changed in one atomic YANG Patch, detaching the monitors given as empty names.
+func (s *PolicyProfileService) SetAVCFlowMonitors(
+	ctx context.Context, profileName string, monitors AVCFlowMonitors,
+) error {
+	if err := s.validateProfileName(profileName); err != nil {
+		return err
+	}
+
+	base := s.buildProfileURL(profileName)
+	patch := core.NewYANGPatch("set-avc-flow-monitors").
+		WithComment("set AVC flow monitors of policy profile " + profileName)
+	edits := []struct {
+		container, monitor string
+		value              any
+	}{
+		{"avc-ipv4-fm-ingress-entries", monitors.IPv4Ingress, AvcIPv4FmIngressEntries{
+			AvcIPv4FmIngressEntry: []AvcIPv4FmIngressEntry{{Name: monitors.IPv4Ingress}}}},
+		{"avc-ipv4-fm-egress-entries", monitors.IPv4Egress, AvcIPv4FmEgressEntries{
+			AvcIPv4FmEgressEntry: []AvcIPv4FmEgressEntry{{Name: monitors.IPv4Egress}}}},
+		{"avc-ipv6-fm-ingress-entries", monitors.IPv6Ingress, AvcIPv6FmIngressEntries{
+			AvcIPv6FmIngressEntry: []AvcIPv6FmIngressEntry{{Name: monitors.IPv6Ingress}}}},
+		{"avc-ipv6-fm-egress-entries", monitors.IPv6Egress, AvcIPv6FmEgressEntries{
+			AvcIPv6FmEgressEntry: []AvcIPv6FmEgressEntry{{Name: monitors.IPv6Egress}}}},
+	}
+	for _, edit := range edits {
+		target := base + "/" + edit.container
+		if edit.monitor == "" {
+			patch.Remove(target)
+			continue
+		}
+		patch.Replace(target, map[string]any{"Cisco-IOS-XE-wireless-wlan-cfg:" + edit.container: edit.value})
+	}
+
+	_, err := core.ApplyYANGPatch(ctx, s.Client(), patch)
+	return err
+}
+
+// switchingPolicyValues is WlanSwitchingPolicy with false flags encoded.
+type switchingPolicyValues struct {
+	CentralSwitching      bool `json:"central-switching"`
+	CentralAuthentication bool `json:"central-authentication"`
+	CentralDHCP           bool `json:"central-dhcp"`
+	CentralAssocEnable    bool `json:"central-assoc-enable"`
+}
+
+// timeoutValues carries the timeouts set by SetTimeouts, including a session timeout of 0.
+type timeoutValues struct {
+	SessionTimeout int `json:"session-timeout"`
+	IdleTimeout    int `json:"idle-timeout"`
+}
+
+// validateProfileName validates policy profile name.
+func (s *PolicyProfileService) validateProfileName(profileName string) error {
+	if profileName == "" {
+		return errors.New("policy profile name cannot be empty")
+	}
+	if len(profileName) > MaxPolicyProfileNameLength {
+		return fmt.Errorf("policy profile validation failed: %w",
+			fmt.Errorf("profile name length validation failed: name too long (max %d characters): '%s'",
+				MaxPolicyProfileNameLength, profileName))
+	}
+	return nil
+}
+
+// buildProfileURL builds URL for specific policy profile operations using RESTCONF builder.
+func (s *PolicyProfileService) buildProfileURL(profileName string) string {
+	return s.Client().RESTCONFBuilder().BuildQueryURL(routes.WLANWlanPolicyQueryPath, profileName)
+}
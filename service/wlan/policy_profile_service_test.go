@@ -0,0 +1,152 @@
+package wlan
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/pkg/testutil"
+)
+
+func TestWlanPolicyProfileServiceUnit_Constructor_Success(t *testing.T) {
+	service := NewService(nil)
+	if service.PolicyProfile() == nil {
+		t.Error("Expected PolicyProfile service, got nil")
+	}
+}
+
+func TestWlanPolicyProfileServiceUnit_Operations_MockSuccess(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller serving one policy profile and recording write requests
+	policiesPath := "Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-policies"
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponses(map[string]string{
+			policiesPath: `{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-policies":{"wlan-policy":[` +
+				`{"policy-profile-name":"default-policy-profile"},{"policy-profile-name":"guest"}]}}`,
+			policiesPath + "/wlan-policy=guest": `{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-policy":[` +
+				`{"policy-profile-name":"guest","interface-name":"100","wlan-switching-policy":{"central-switching":true},` +
+				`"wlan-timeout":{"session-timeout":1800,"idle-timeout":300}}]}`,
+		}),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+
+	client := testutil.NewTestClient(server).Core().(*core.Client)
+	profiles := NewPolicyProfileService(client)
+	ctx := testutil.TestContext(t)
+
+	profile, err := profiles.GetPolicyProfile(ctx, "guest")
+	if err != nil || profile == nil {
+		t.Fatalf("GetPolicyProfile failed: %v, %+v", err, profile)
+	}
+	if profile.InterfaceName != "100" || !profile.WlanSwitchingPolicy.CentralSwitching ||
+		profile.WlanTimeout.IdleTimeout != 300 {
+		t.Errorf("Unexpected policy profile: %+v", profile)
+	}
+	list, err := profiles.ListPolicyProfiles(ctx)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListPolicyProfiles failed: %v, %+v", err, list)
+	}
+
+	config := &WlanPolicy{PolicyProfileName: "guest", Status: true, InterfaceName: "100"}
+	if err := profiles.CreatePolicyProfile(ctx, config); err != nil {
+		t.Fatalf("CreatePolicyProfile failed: %v", err)
+	}
+	if err := profiles.ReplacePolicyProfile(ctx, config); err != nil {
+		t.Fatalf("ReplacePolicyProfile failed: %v", err)
+	}
+	if err := profiles.SetVLAN(ctx, "guest", "guest-vlan"); err != nil {
+		t.Fatalf("SetVLAN failed: %v", err)
+	}
+	if err := profiles.SetSwitchingPolicy(ctx, "guest", WlanSwitchingPolicy{CentralAuthentication: true}); err != nil {
+		t.Fatalf("SetSwitchingPolicy failed: %v", err)
+	}
+	if err := profiles.SetTimeouts(ctx, "guest", 0, 600); err != nil {
+		t.Fatalf("SetTimeouts failed: %v", err)
+	}
+	if err := profiles.SetQoSPolicies(ctx, "guest", "platinum-up", ""); err != nil {
+		t.Fatalf("SetQoSPolicies failed: %v", err)
+	}
+	if err := profiles.RemoveQoSPolicy(ctx, "guest", QoSDirectionIngress); err != nil {
+		t.Fatalf("RemoveQoSPolicy failed: %v", err)
+	}
+	if err := profiles.SetAVCFlowMonitors(ctx, "guest", AVCFlowMonitors{IPv4Ingress: "avc-in"}); err != nil {
+		t.Fatalf("SetAVCFlowMonitors failed: %v", err)
+	}
+	if err := profiles.DeletePolicyProfile(ctx, "guest"); err != nil {
+		t.Fatalf("DeletePolicyProfile failed: %v", err)
+	}
+
+	profilePath := "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-policies/wlan-policy=guest"
+	expected := []struct{ method, path, contains string }{
+		{http.MethodPost, "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-policies",
+			`"Cisco-IOS-XE-wireless-wlan-cfg:wlan-policy":{"policy-profile-name":"guest"`},
+		{http.MethodPut, profilePath, `"interface-name":"100"`},
+		{http.MethodPatch, profilePath, `"interface-name":"guest-vlan"`},
+		{http.MethodPatch, profilePath + "/wlan-switching-policy",
+			`{"central-switching":false,"central-authentication":true,"central-dhcp":false`},
+		{http.MethodPatch, profilePath + "/wlan-timeout", `{"session-timeout":0,"idle-timeout":600}`},
+		{http.MethodPatch, profilePath + "/per-ssid-qos",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:per-ssid-qos":{"ingress-service-name":"platinum-up"}}`},
+		{http.MethodDelete, profilePath + "/per-ssid-qos/ingress-service-name", ""},
+		{http.MethodPatch, "/restconf/data", `"operation":"replace","target":"/Cisco-IOS-XE-wireless-wlan-cfg:` +
+			`wlan-cfg-data/wlan-policies/wlan-policy=guest/avc-ipv4-fm-ingress-entries"`},
+		{http.MethodDelete, profilePath, ""},
+	}
+	writes := recorder.Writes()
+	if len(writes) != len(expected) {
+		t.Fatalf("Expected %d write requests, got %d", len(expected), len(writes))
+	}
+	for i, want := range expected {
+		got := writes[i]
+		if got.Method != want.method || got.Path != want.path || !strings.Contains(got.Body, want.contains) {
+			t.Errorf("Request %d: expected %s %s containing %q, got %s %s %s",
+				i, want.method, want.path, want.contains, got.Method, got.Path, got.Body)
+		}
+	}
+	if qos := writes[5].Body; strings.Contains(qos, "egress") {
+		t.Errorf("Expected ingress-only QoS policies to leave egress alone, got %s", qos)
+	}
+	if avc := writes[7].Body; strings.Count(avc, `"operation":"remove"`) != 3 {
+		t.Errorf("Expected detached monitors to be removed, got %s", avc)
+	}
+}
+
+func TestWlanPolicyProfileServiceUnit_ValidationErrors(t *testing.T) {
+	profiles := NewPolicyProfileService(nil)
+	ctx := testutil.TestContext(t)
+	longName := strings.Repeat("p", MaxPolicyProfileNameLength+1)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"GetEmptyName", func() error { _, err := profiles.GetPolicyProfile(ctx, ""); return err }},
+		{"CreateNil", func() error { return profiles.CreatePolicyProfile(ctx, nil) }},
+		{"CreateLongName", func() error {
+			return profiles.CreatePolicyProfile(ctx, &WlanPolicy{PolicyProfileName: longName})
+		}},
+		{"ReplaceNil", func() error { return profiles.ReplacePolicyProfile(ctx, nil) }},
+		{"DeleteEmptyName", func() error { return profiles.DeletePolicyProfile(ctx, "") }},
+		{"EmptyVLAN", func() error { return profiles.SetVLAN(ctx, "guest", "") }},
+		{"VLANOutOfRange", func() error { return profiles.SetVLAN(ctx, "guest", "4095") }},
+		{"SwitchingEmptyName", func() error { return profiles.SetSwitchingPolicy(ctx, "", WlanSwitchingPolicy{}) }},
+		{"NegativeSessionTimeout", func() error { return profiles.SetTimeouts(ctx, "guest", -1, 300) }},
+		{"LongSessionTimeout", func() error { return profiles.SetTimeouts(ctx, "guest", MaxSessionTimeout+1, 300) }},
+		{"ShortIdleTimeout", func() error { return profiles.SetTimeouts(ctx, "guest", 1800, 10) }},
+		{"QoSEmptyName", func() error { return profiles.SetQoSPolicies(ctx, "", "in", "out") }},
+		{"QoSNoPolicy", func() error { return profiles.SetQoSPolicies(ctx, "guest", "", "") }},
+		{"RemoveQoSInvalidDirection", func() error { return profiles.RemoveQoSPolicy(ctx, "guest", "both") }},
+		{"AVCLongName", func() error { return profiles.SetAVCFlowMonitors(ctx, longName, AVCFlowMonitors{}) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err == nil {
+				t.Errorf("Expected validation error for %s", tt.name)
+			}
+		})
+	}
+}
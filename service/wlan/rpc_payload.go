@@ -4,3 +4,13 @@ package wlan
 type CiscoIOSXEWirelessWlanPolicyListEntriesPayload struct {
 	PolicyListEntry PolicyListEntry `json:"Cisco-IOS-XE-wireless-wlan-cfg:policy-list-entry"`
 }
+
+// CiscoIOSXEWirelessWlanCfgEntryPayload represents request structure for wlan-cfg-entries endpoint.
+type CiscoIOSXEWirelessWlanCfgEntryPayload struct {
+	WlanCfgEntry WlanCfgEntry `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry"`
+}
+
+// CiscoIOSXEWirelessWlanPolicyPayload represents request structure for wlan-policies endpoint.
+type CiscoIOSXEWirelessWlanPolicyPayload struct {
+	WlanPolicy WlanPolicy `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-policy"`
+}
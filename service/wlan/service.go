@@ -3,12 +3,25 @@ package wlan
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf/routes"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/service"
 )
 
+// WLAN configuration limits enforced before write operations.
+const (
+	MinWLANID            = 1    // Lowest WLAN ID accepted by the controller
+	MaxWLANID            = 4096 // Highest WLAN ID accepted by the controller
+	MaxSSIDLength        = 32   // Maximum SSID length in bytes (IEEE 802.11)
+	MaxProfileNameLength = 32   // Maximum WLAN profile name length
+	MinPSKLength         = 8    // Minimum WPA passphrase length
+	MaxPSKLength         = 63   // Maximum WPA passphrase length; 64 characters are read as a hex key
+)
+
 // Service provides WLAN operations for Cisco IOS-XE Wireless LAN Controller.
 type Service struct {
 	service.BaseService
@@ -24,6 +37,11 @@ func (s Service) PolicyTag() *PolicyTagService {
 	return NewPolicyTagService(s.Client())
 }
 
+// PolicyProfile returns a PolicyProfileService for policy profile operations.
+func (s Service) PolicyProfile() *PolicyProfileService {
+	return NewPolicyProfileService(s.Client())
+}
+
 // GetConfig retrieves the complete WLAN configuration.
 func (s Service) GetConfig(ctx context.Context) (*CiscoIOSXEWirelessWlanCfg, error) {
 	return core.Get[CiscoIOSXEWirelessWlanCfg](ctx, s.Client(), routes.WLANCfgPath)
@@ -89,3 +107,192 @@ func (s Service) ListDot11beProfiles(ctx context.Context) (*CiscoIOSXEWirelessWl
 func (s Service) ListWlanInfo(ctx context.Context) (*CiscoIOSXEWirelessWlanGlobalOperWlanInfo, error) {
 	return core.Get[CiscoIOSXEWirelessWlanGlobalOperWlanInfo](ctx, s.Client(), routes.WLANWlanInfoPath)
 }
+
+// GetWLAN retrieves the configuration of the WLAN with the given profile name.
+func (s Service) GetWLAN(ctx context.Context, profileName string) (*WlanCfgEntry, error) {
+	if err := validateProfileName(profileName); err != nil {
+		return nil, err
+	}
+
+	result, err := core.Get[CiscoIOSXEWirelessWlanCfgWlanCfgEntry](ctx, s.Client(), s.buildWLANURL(profileName))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.WlanCfgEntry) == 0 {
+		return nil, nil
+	}
+
+	return &result.WlanCfgEntry[0], nil
+}
+
+// CreateWLAN creates a WLAN. The entry must carry a WLAN ID, a profile name and an SSID in
+// APFVapIDData, and a pre-shared key when PSK key management is enabled.
+func (s Service) CreateWLAN(ctx context.Context, config *WlanCfgEntry) error {
+	if err := validateWLAN(config); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessWlanCfgEntryPayload{WlanCfgEntry: *config}
+	return core.PostVoid(ctx, s.Client(), routes.WLANWlanCfgEntriesPath, payload)
+}
+
+// UpdateWLAN replaces the configuration of the WLAN with the same profile name using PUT operation,
+// so key management modes and other flags left false are turned off. Settings not present in the entry,
+// including those not modeled by WlanCfgEntry, are reset to their defaults; use SetWLANStatus or
+// RotatePSK to change only the status or the pre-shared key.
+func (s Service) UpdateWLAN(ctx context.Context, config *WlanCfgEntry) error {
+	if err := validateWLAN(config); err != nil {
+		return err
+	}
+
+	payload := CiscoIOSXEWirelessWlanCfgEntryPayload{WlanCfgEntry: *config}
+	return core.PutVoid(ctx, s.Client(), s.buildWLANURL(config.ProfileName), payload)
+}
+
+// SetWLANStatus enables or disables (shuts down) a WLAN using PATCH operation, leaving its other
+// settings untouched.
+func (s Service) SetWLANStatus(ctx context.Context, profileName string, enabled bool) error {
+	if _, err := s.getExistingWLAN(ctx, profileName); err != nil {
+		return err
+	}
+
+	payload := struct {
+		Status wlanStatusValues `json:"Cisco-IOS-XE-wireless-wlan-cfg:apf-vap-id-data"`
+	}{Status: wlanStatusValues{WlanStatus: enabled}}
+	return core.PatchVoid(ctx, s.Client(), s.buildWLANURL(profileName)+"/apf-vap-id-data", payload)
+}
+
+// RotatePSK replaces the pre-shared key of a WLAN using PSK key management with PATCH operation,
+// leaving its other settings untouched. The passphrase is sent in clear text and encrypted by the
+// controller when password encryption is enabled.
+func (s Service) RotatePSK(ctx context.Context, profileName, psk string) error {
+	if err := validatePSK(psk); err != nil {
+		return err
+	}
+	config, err := s.getExistingWLAN(ctx, profileName)
+	if err != nil {
+		return err
+	}
+	if !config.AuthKeyMgmtPsk {
+		return fmt.Errorf("WLAN operation failed: %w",
+			fmt.Errorf("WLAN '%s' does not use PSK key management", profileName))
+	}
+
+	payload := struct {
+		PSK pskValues `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry"`
+	}{PSK: pskValues{ProfileName: profileName, PSK: psk, PSKType: PSKTypeClear}}
+	return core.PatchVoid(ctx, s.Client(), s.buildWLANURL(profileName), payload)
+}
+
+// DeleteWLAN deletes a WLAN.
+func (s Service) DeleteWLAN(ctx context.Context, profileName string) error {
+	if err := validateProfileName(profileName); err != nil {
+		return err
+	}
+	return core.Delete(ctx, s.Client(), s.buildWLANURL(profileName))
+}
+
+// getExistingWLAN retrieves a WLAN for read-modify-write operations, failing when it does not exist.
+func (s Service) getExistingWLAN(ctx context.Context, profileName string) (*WlanCfgEntry, error) {
+	config, err := s.GetWLAN(ctx, profileName)
+	if err != nil {
+		return nil, fmt.Errorf("WLAN operation failed: %w",
+			fmt.Errorf("WLAN retrieval failed for '%s': %w", profileName, err))
+	}
+	if config == nil {
+		return nil, fmt.Errorf("WLAN operation failed: %w",
+			fmt.Errorf("WLAN '%s' not found in controller configuration", profileName))
+	}
+	return config, nil
+}
+
+// wlanStatusValues carries the status set by SetWLANStatus, including a disabled status.
+type wlanStatusValues struct {
+	WlanStatus bool `json:"wlan-status"`
+}
+
+// pskValues carries the pre-shared key set by RotatePSK with the key of the WLAN entry.
+type pskValues struct {
+	ProfileName string `json:"profile-name"`
+	PSK         string `json:"psk"`
+	PSKType     string `json:"psk-type"`
+}
+
+// buildWLANURL builds URL for specific WLAN operations using RESTCONF builder.
+func (s Service) buildWLANURL(profileName string) string {
+	return s.Client().RESTCONFBuilder().BuildQueryURL(routes.WLANWlanCfgEntryQueryPath, profileName)
+}
+
+// validateProfileName validates WLAN profile name.
+func validateProfileName(profileName string) error {
+	if profileName == "" {
+		return errors.New("WLAN profile name cannot be empty")
+	}
+	if len(profileName) > MaxProfileNameLength {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("profile name length validation failed: name too long (max %d characters): '%s'",
+				MaxProfileNameLength, profileName))
+	}
+	return nil
+}
+
+// validateWLAN validates the WLAN ID, SSID, key management settings and clear-text pre-shared key
+// of a complete WLAN entry.
+func validateWLAN(config *WlanCfgEntry) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+	if err := validateProfileName(config.ProfileName); err != nil {
+		return err
+	}
+	if config.WlanID < MinWLANID || config.WlanID > MaxWLANID {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("WLAN ID range validation failed: %d not in %d-%d", config.WlanID, MinWLANID, MaxWLANID))
+	}
+	if config.APFVapIDData == nil || config.APFVapIDData.SSID == "" {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("SSID cannot be empty for '%s'", config.ProfileName))
+	}
+	if len(config.APFVapIDData.SSID) > MaxSSIDLength {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("SSID length validation failed: SSID too long (max %d bytes): '%s'",
+				MaxSSIDLength, config.APFVapIDData.SSID))
+	}
+	if config.AuthKeyMgmtPsk && (config.AuthKeyMgmtDot1x || config.AuthKeyMgmtDot1xSha256) {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("conflicting key management on '%s': PSK cannot be combined with 802.1X", config.ProfileName))
+	}
+	if config.PSK != "" && !config.AuthKeyMgmtPsk {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("pre-shared key given on '%s' without PSK key management", config.ProfileName))
+	}
+	if config.AuthKeyMgmtPsk && config.PSK == "" {
+		return fmt.Errorf("WLAN validation failed: %w",
+			fmt.Errorf("pre-shared key is required for PSK key management on '%s'", config.ProfileName))
+	}
+	if config.PSK != "" && (config.PSKType == "" || config.PSKType == PSKTypeClear) {
+		return validatePSK(config.PSK)
+	}
+	return nil
+}
+
+// validatePSK validates a clear-text WPA passphrase (8-63 printable ASCII) or 64-digit hex key.
+func validatePSK(psk string) error {
+	if len(psk) == MaxPSKLength+1 {
+		for _, c := range psk {
+			if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+				return errors.New("PSK validation failed: 64-character key must be hexadecimal")
+			}
+		}
+		return nil
+	}
+	if len(psk) < MinPSKLength || len(psk) > MaxPSKLength {
+		return fmt.Errorf("PSK validation failed: length %d not in %d-%d", len(psk), MinPSKLength, MaxPSKLength)
+	}
+	for _, c := range psk {
+		if c < ' ' || c > '~' {
+			return errors.New("PSK validation failed: passphrase must be printable ASCII")
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package wlan
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
@@ -288,3 +290,159 @@ func TestWlanServiceUnit_ErrorHandling_NilClient(t *testing.T) {
 
 	// Note: ListDot11beProfiles and ListWlanInfo are not tested with nil client as they may not be supported by all mock servers
 }
+
+func TestWlanServiceUnit_WLANLifecycle_Success(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller serving one PSK WLAN and recording write requests
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponse("Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries/wlan-cfg-entry=guest",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":[{"wlan-id":17,"profile-name":"guest",`+
+				`"auth-key-mgmt-psk":true,"psk":"ENCRYPTED","psk-type":"aes",`+
+				`"apf-vap-id-data":{"ssid":"Guest","wlan-status":true}}]}`),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+
+	client := testutil.NewTestClient(server).Core().(*core.Client)
+	service := NewService(client)
+	ctx := testutil.TestContext(t)
+
+	entry := &WlanCfgEntry{
+		WlanID:         17,
+		ProfileName:    "guest",
+		AuthKeyMgmtPsk: true,
+		PSK:            "correct horse",
+		APFVapIDData:   &APFVapIDData{SSID: "Guest", WlanStatus: true},
+	}
+	if err := service.CreateWLAN(ctx, entry); err != nil {
+		t.Fatalf("CreateWLAN failed: %v", err)
+	}
+	if err := service.UpdateWLAN(ctx, entry); err != nil {
+		t.Fatalf("UpdateWLAN failed: %v", err)
+	}
+	if err := service.SetWLANStatus(ctx, "guest", false); err != nil {
+		t.Fatalf("SetWLANStatus failed: %v", err)
+	}
+	if err := service.RotatePSK(ctx, "guest", "new passphrase"); err != nil {
+		t.Fatalf("RotatePSK failed: %v", err)
+	}
+	openEntry := &WlanCfgEntry{WlanID: 17, ProfileName: "guest", APFVapIDData: &APFVapIDData{SSID: "Guest"}}
+	if err := service.UpdateWLAN(ctx, openEntry); err != nil {
+		t.Fatalf("UpdateWLAN to open authentication failed: %v", err)
+	}
+	if err := service.DeleteWLAN(ctx, "guest"); err != nil {
+		t.Fatalf("DeleteWLAN failed: %v", err)
+	}
+
+	entryPath := "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries/wlan-cfg-entry=guest"
+	expected := []struct{ method, path, contains string }{
+		{http.MethodPost, "/restconf/data/Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries",
+			`"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":{"wlan-id":17`},
+		{http.MethodPut, entryPath, `"psk":"correct horse"`},
+		{http.MethodPatch, entryPath + "/apf-vap-id-data",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:apf-vap-id-data":{"wlan-status":false}}`},
+		{http.MethodPatch, entryPath, `{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":` +
+			`{"profile-name":"guest","psk":"new passphrase","psk-type":"clear"}}`},
+		{http.MethodPut, entryPath, `{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":{"wlan-id":17,` +
+			`"profile-name":"guest","apf-vap-id-data":{"ssid":"Guest","wlan-status":false}}}`},
+		{http.MethodDelete, entryPath, ""},
+	}
+	writes := recorder.Writes()
+	if len(writes) != len(expected) {
+		t.Fatalf("Expected %d write requests, got %d", len(expected), len(writes))
+	}
+	for i, want := range expected {
+		got := writes[i]
+		matches := strings.Contains(got.Body, want.contains)
+		if got.Method == http.MethodPatch {
+			matches = got.Body == want.contains // Focused setters send only the changed leaves
+		}
+		if got.Method != want.method || got.Path != want.path || !matches {
+			t.Errorf("Request %d: expected %s %s containing %q, got %s %s %s",
+				i, want.method, want.path, want.contains, got.Method, got.Path, got.Body)
+		}
+	}
+}
+
+func TestWlanServiceUnit_RotatePSK_LegacyEntry(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller serving a PSK WLAN whose SSID fails the checks of CreateWLAN and UpdateWLAN
+	recorder := &testutil.RequestRecorder{}
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponse("Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/wlan-cfg-entries/wlan-cfg-entry=legacy",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-entry":[{"wlan-id":3,"profile-name":"legacy",`+
+				`"auth-key-mgmt-psk":true,"psk":"ENCRYPTED","psk-type":"aes",`+
+				`"apf-vap-id-data":{"ssid":"`+strings.Repeat("s", MaxSSIDLength+1)+`","wlan-status":true}}]}`),
+		testutil.WithRequestRecorder(recorder),
+	)
+	defer server.Close()
+
+	service := NewService(testutil.NewTestClient(server).Core().(*core.Client))
+	if err := service.RotatePSK(testutil.TestContext(t), "legacy", "new passphrase"); err != nil {
+		t.Fatalf("RotatePSK failed: %v", err)
+	}
+	if writes := recorder.Writes(); len(writes) != 1 || strings.Contains(writes[0].Body, "ssid") {
+		t.Errorf("Expected one PATCH of the pre-shared key only, got %+v", writes)
+	}
+}
+
+func TestWlanServiceUnit_WLANValidation_Errors(t *testing.T) {
+	service := NewService(nil)
+	ctx := testutil.TestContext(t)
+	valid := func() *WlanCfgEntry {
+		return &WlanCfgEntry{WlanID: 1, ProfileName: "corp", APFVapIDData: &APFVapIDData{SSID: "Corp"}}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*WlanCfgEntry)
+		errMsg string
+	}{
+		{"WLANIDZero", func(e *WlanCfgEntry) { e.WlanID = 0 }, "WLAN ID range"},
+		{"WLANIDTooLarge", func(e *WlanCfgEntry) { e.WlanID = MaxWLANID + 1 }, "WLAN ID range"},
+		{"EmptyProfileName", func(e *WlanCfgEntry) { e.ProfileName = "" }, "profile name cannot be empty"},
+		{"LongProfileName", func(e *WlanCfgEntry) { e.ProfileName = strings.Repeat("p", 33) }, "name too long"},
+		{"MissingSSID", func(e *WlanCfgEntry) { e.APFVapIDData = nil }, "SSID cannot be empty"},
+		{"LongSSID", func(e *WlanCfgEntry) { e.APFVapIDData.SSID = strings.Repeat("s", 33) }, "SSID too long"},
+		{"PSKWithDot1x", func(e *WlanCfgEntry) {
+			e.AuthKeyMgmtPsk, e.AuthKeyMgmtDot1x, e.PSK = true, true, "passphrase"
+		}, "conflicting key management"},
+		{"PSKWithDot1xSHA256", func(e *WlanCfgEntry) {
+			e.AuthKeyMgmtPsk, e.AuthKeyMgmtDot1xSha256, e.PSK = true, true, "passphrase"
+		}, "conflicting key management"},
+		{"PSKWithoutKeyManagement", func(e *WlanCfgEntry) { e.PSK = "passphrase" }, "without PSK key management"},
+		{"MissingPSK", func(e *WlanCfgEntry) { e.AuthKeyMgmtPsk = true }, "pre-shared key is required"},
+		{"ShortPSK", func(e *WlanCfgEntry) { e.AuthKeyMgmtPsk, e.PSK = true, "short" }, "PSK validation failed"},
+		{"InvalidHexPSK", func(e *WlanCfgEntry) {
+			e.AuthKeyMgmtPsk, e.PSK = true, strings.Repeat("g", 64)
+		}, "must be hexadecimal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := valid()
+			tt.modify(entry)
+			if err := service.CreateWLAN(ctx, entry); err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Expected CreateWLAN error containing %q, got %v", tt.errMsg, err)
+			}
+			if err := service.UpdateWLAN(ctx, entry); err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Expected UpdateWLAN error containing %q, got %v", tt.errMsg, err)
+			}
+		})
+	}
+
+	if err := service.UpdateWLAN(ctx, nil); err == nil {
+		t.Error("Expected error for nil config")
+	}
+	if err := service.RotatePSK(ctx, "corp", "short"); err == nil {
+		t.Error("Expected error for short PSK")
+	}
+	if err := service.SetWLANStatus(ctx, "", true); err == nil {
+		t.Error("Expected error for empty profile name")
+	}
+	if err := service.DeleteWLAN(ctx, ""); err == nil {
+		t.Error("Expected error for empty profile name")
+	}
+}
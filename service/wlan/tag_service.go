@@ -99,6 +99,49 @@ func (s *PolicyTagService) SetPolicyTag(ctx context.Context, config *PolicyListE
 	return core.PatchVoid(ctx, s.Client(), s.buildTagURL(config.TagName), payload)
 }
 
+// GetPolicyTagWithMeta retrieves a specific policy tag together with its ETag and Last-Modified
+// validators. Pass the metadata to SetPolicyTagIfUnchanged to detect concurrent modifications.
+func (s *PolicyTagService) GetPolicyTagWithMeta(
+	ctx context.Context, tagName string,
+) (*PolicyListEntry, *core.ResponseMeta, error) {
+	if err := s.validateTagName(tagName); err != nil {
+		return nil, nil, err
+	}
+
+	result, meta, err := core.GetWithMeta[CiscoIOSXEWirelessWlanCfgPolicyListEntry](
+		ctx, s.Client(), s.buildTagURL(tagName), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if result == nil || len(result.PolicyListEntry) == 0 {
+		return nil, meta, nil
+	}
+
+	return &result.PolicyListEntry[0], meta, nil
+}
+
+// SetPolicyTagIfUnchanged updates a policy tag only if it has not changed since meta was retrieved
+// with GetPolicyTagWithMeta. A concurrent modification fails with an error for which
+// core.IsPreconditionFailed reports true.
+func (s *PolicyTagService) SetPolicyTagIfUnchanged(
+	ctx context.Context, config *PolicyListEntry, meta *core.ResponseMeta,
+) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+	if err := s.validateTagName(config.TagName); err != nil {
+		return err
+	}
+	conds := meta.Conditions()
+	if len(conds) == 0 {
+		return fmt.Errorf("policy tag operation failed: %w",
+			fmt.Errorf("no ETag or Last-Modified validator for tag '%s'", config.TagName))
+	}
+
+	payload := s.buildPayload(*config)
+	return core.PatchVoid(ctx, s.Client(), s.buildTagURL(config.TagName), payload, conds...)
+}
+
 // SetPolicyProfile sets the policy profile for a specific WLAN in a policy tag.
 func (s *PolicyTagService) SetPolicyProfile(
 	ctx context.Context,
@@ -578,3 +578,45 @@ func TestWlanPolicyTagServiceUnit_ValidationErrors_AdvancedScenarios(t *testing.
 		}
 	})
 }
+
+func TestWlanPolicyTagServiceUnit_ConditionalUpdate_Success(t *testing.T) {
+	t.Parallel()
+
+	// Mock controller tracking the policy tag entity tag, bumped on every successful write
+	server := testutil.NewMockServer(
+		testutil.WithSuccessResponse(
+			"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data/policy-list-entries/policy-list-entry=test-policy-tag",
+			`{"Cisco-IOS-XE-wireless-wlan-cfg:policy-list-entry":[{"tag-name":"test-policy-tag"}]}`),
+		testutil.WithEntityTags(),
+	)
+	defer server.Close()
+
+	client := testutil.NewTestClient(server).Core().(*core.Client)
+	policyTag := NewPolicyTagService(client)
+	ctx := testutil.TestContext(t)
+
+	// Two jobs read the same version of the tag
+	first, firstMeta, err := policyTag.GetPolicyTagWithMeta(ctx, "test-policy-tag")
+	if err != nil || first == nil || first.TagName != "test-policy-tag" {
+		t.Fatalf("GetPolicyTagWithMeta failed: %v, %+v", err, first)
+	}
+	second, secondMeta, err := policyTag.GetPolicyTagWithMeta(ctx, "test-policy-tag")
+	if err != nil {
+		t.Fatalf("GetPolicyTagWithMeta failed: %v", err)
+	}
+	if firstMeta.ETag != `"v1"` {
+		t.Errorf("Expected ETag \"v1\", got %q", firstMeta.ETag)
+	}
+
+	first.Description = "first job"
+	if err := policyTag.SetPolicyTagIfUnchanged(ctx, first, firstMeta); err != nil {
+		t.Errorf("First update failed: %v", err)
+	}
+	second.Description = "second job"
+	if err := policyTag.SetPolicyTagIfUnchanged(ctx, second, secondMeta); !core.IsPreconditionFailed(err) {
+		t.Errorf("Expected precondition failure for stale update, got %v", err)
+	}
+	if err := policyTag.SetPolicyTagIfUnchanged(ctx, second, nil); err == nil {
+		t.Error("Expected error for missing validators")
+	}
+}
@@ -2,10 +2,16 @@
 package wnc
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/core"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/restconf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/internal/transport"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/service/afc"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/service/ap"
 	"github.com/umatare5/cisco-ios-xe-wireless-go/service/apf"
@@ -55,6 +61,70 @@ var (
 // APIError is returned for HTTP error responses (type alias to preserve instanceof semantics with errors.As).
 type APIError = core.APIError
 
+// RESTCONFError is a structured entry of an "ietf-restconf:errors" body, available in APIError.Errors.
+type RESTCONFError = core.RESTCONFError
+
+// RESTCONF error-tag values matched by HasErrorTag (RFC 8040 §7).
+const (
+	ErrorTagInUse                 = core.ErrorTagInUse
+	ErrorTagInvalidValue          = core.ErrorTagInvalidValue
+	ErrorTagTooBig                = core.ErrorTagTooBig
+	ErrorTagMissingAttribute      = core.ErrorTagMissingAttribute
+	ErrorTagBadAttribute          = core.ErrorTagBadAttribute
+	ErrorTagUnknownAttribute      = core.ErrorTagUnknownAttribute
+	ErrorTagBadElement            = core.ErrorTagBadElement
+	ErrorTagUnknownElement        = core.ErrorTagUnknownElement
+	ErrorTagUnknownNamespace      = core.ErrorTagUnknownNamespace
+	ErrorTagAccessDenied          = core.ErrorTagAccessDenied
+	ErrorTagLockDenied            = core.ErrorTagLockDenied
+	ErrorTagResourceDenied        = core.ErrorTagResourceDenied
+	ErrorTagRollbackFailed        = core.ErrorTagRollbackFailed
+	ErrorTagDataExists            = core.ErrorTagDataExists
+	ErrorTagDataMissing           = core.ErrorTagDataMissing
+	ErrorTagOperationNotSupported = core.ErrorTagOperationNotSupported
+	ErrorTagOperationFailed       = core.ErrorTagOperationFailed
+	ErrorTagMalformedMessage      = core.ErrorTagMalformedMessage
+)
+
+// HasErrorTag reports whether err is an APIError carrying a RESTCONF error with the given tag.
+func HasErrorTag(err error, tag string) bool { return core.HasErrorTag(err, tag) }
+
+// IsDataExists reports whether err indicates that the data to be created already exists.
+func IsDataExists(err error) bool { return core.IsDataExists(err) }
+
+// IsDataMissing reports whether err indicates that the data to be modified does not exist.
+func IsDataMissing(err error) bool { return core.IsDataMissing(err) }
+
+// IsLockDenied reports whether err indicates that the datastore is locked by another session.
+func IsLockDenied(err error) bool { return core.IsLockDenied(err) }
+
+// IsInvalidValue reports whether err indicates that a request value was rejected.
+func IsInvalidValue(err error) bool { return core.IsInvalidValue(err) }
+
+// IsAccessDenied reports whether err indicates that access control denied the operation.
+func IsAccessDenied(err error) bool { return core.IsAccessDenied(err) }
+
+// IsPreconditionFailed reports whether err is an HTTP 412 response to a conditional write.
+func IsPreconditionFailed(err error) bool { return core.IsPreconditionFailed(err) }
+
+// ResponseMeta holds the ETag and Last-Modified validators of a response (type alias to core.ResponseMeta).
+type ResponseMeta = core.ResponseMeta
+
+// Condition sets a conditional request header on a single call (type alias to core.Condition).
+type Condition = core.Condition
+
+// IfMatch makes a write succeed only while the resource still has the given entity tag.
+func IfMatch(etag string) Condition { return core.IfMatch(etag) }
+
+// IfNoneMatch makes a GET return HTTP 304 Not Modified while the resource has the given entity tag.
+func IfNoneMatch(etag string) Condition { return core.IfNoneMatch(etag) }
+
+// IfModifiedSince makes a GET return HTTP 304 Not Modified unless the resource changed after t.
+func IfModifiedSince(t time.Time) Condition { return core.IfModifiedSince(t) }
+
+// IfUnmodifiedSince makes a write succeed only if the resource has not changed after t.
+func IfUnmodifiedSince(t time.Time) Condition { return core.IfUnmodifiedSince(t) }
+
 // Client represents the unified WNC API client with access to all domain services.
 // This provides a single-import approach to accessing all wireless controller functionality.
 type Client struct {
@@ -71,6 +141,45 @@ func NewClient(host, token string, opts ...Option) (*Client, error) {
 	return &Client{core: coreClient}, nil
 }
 
+// NewClientWithCredentials creates a new unified WNC client authenticating with a username and password.
+func NewClientWithCredentials(host, username, password string, opts ...Option) (*Client, error) {
+	if username == "" || password == "" {
+		return nil, errors.New("client initialization failed: username and password must not be empty")
+	}
+	return NewClientWithAuthenticator(host, transport.NewBasicAuth(username, password), opts...)
+}
+
+// NewClientWithAuthenticator creates a new unified WNC client that signs requests with auth.
+func NewClientWithAuthenticator(host string, auth Authenticator, opts ...Option) (*Client, error) {
+	coreClient, err := core.NewWithAuthenticator(host, auth, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{core: coreClient}, nil
+}
+
+// Authenticator applies credentials to outgoing requests (type alias to the internal transport type).
+type Authenticator = transport.Authenticator
+
+// Refresher is implemented by authenticators that renew credentials after an HTTP 401.
+type Refresher = transport.Refresher
+
+// CredentialProvider returns a username and password pair on demand (env, file, secret store callback).
+type CredentialProvider = transport.CredentialProvider
+
+// NewCredentialAuth creates an authenticator that loads credentials from provider and refreshes them on 401.
+func NewCredentialAuth(provider CredentialProvider) Authenticator {
+	return transport.NewCredentialAuth(provider)
+}
+
+// EnvCredentials returns a provider reading credentials from the named environment variables.
+func EnvCredentials(usernameVar, passwordVar string) CredentialProvider {
+	return transport.EnvCredentials(usernameVar, passwordVar)
+}
+
+// FileCredentials returns a provider reading "username:password" from the first line of a file.
+func FileCredentials(path string) CredentialProvider { return transport.FileCredentials(path) }
+
 // Option is a functional option for configuring the unified client (re-export of internal core.Option).
 // This allows end users to supply options without importing the internal/core package.
 type Option = core.Option
@@ -81,18 +190,191 @@ func WithTimeout(d time.Duration) Option { return core.WithTimeout(d) }
 // WithInsecureSkipVerify controls TLS certificate verification (lab/testing only).
 func WithInsecureSkipVerify(skip bool) Option { return core.WithInsecureSkipVerify(skip) }
 
+// WithRootCAs sets the pool of trusted root CAs used to verify the controller certificate.
+func WithRootCAs(pool *x509.CertPool) Option { return core.WithRootCAs(pool) }
+
+// WithCAFile trusts the PEM encoded CA bundle at path instead of the system roots.
+func WithCAFile(path string) Option { return core.WithCAFile(path) }
+
+// WithCAPEM trusts the PEM encoded CA certificates instead of the system roots.
+func WithCAPEM(pemData []byte) Option { return core.WithCAPEM(pemData) }
+
+// WithClientCertificate presents cert to controllers that require mutual TLS.
+func WithClientCertificate(cert tls.Certificate) Option { return core.WithClientCertificate(cert) }
+
+// WithClientCertificatePEM presents the PEM encoded certificate and key for mutual TLS.
+func WithClientCertificatePEM(certPEM, keyPEM []byte) Option {
+	return core.WithClientCertificatePEM(certPEM, keyPEM)
+}
+
+// WithClientCertificateFiles presents the certificate and key PEM files for mutual TLS.
+func WithClientCertificateFiles(certFile, keyFile string) Option {
+	return core.WithClientCertificateFiles(certFile, keyFile)
+}
+
+// WithTLSMinVersion sets the minimum TLS version (tls.VersionTLS12 or tls.VersionTLS13).
+func WithTLSMinVersion(version uint16) Option { return core.WithTLSMinVersion(version) }
+
+// WithTLSServerName overrides the server name used for certificate verification and SNI.
+func WithTLSServerName(name string) Option { return core.WithTLSServerName(name) }
+
+// WithSPKIPins pins the controller public key to SHA-256 SPKI digests ("sha256/<base64>").
+func WithSPKIPins(pins ...string) Option { return core.WithSPKIPins(pins...) }
+
 // WithLogger sets a custom slog.Logger.
 func WithLogger(l *slog.Logger) Option { return core.WithLogger(l) }
 
 // WithUserAgent sets a custom User-Agent header value.
 func WithUserAgent(ua string) Option { return core.WithUserAgent(ua) }
 
+// WithHeader sets an extra header sent with every request.
+func WithHeader(key, value string) Option { return core.WithHeader(key, value) }
+
+// WithHeaders sets extra headers sent with every request (correlation IDs, proxy auth).
+func WithHeaders(headers map[string]string) Option { return core.WithHeaders(headers) }
+
+// WithAuthenticator replaces the authenticator used to sign requests.
+func WithAuthenticator(auth Authenticator) Option { return core.WithAuthenticator(auth) }
+
+// WithSessionReuse enables reuse of controller session cookies to avoid AAA on every request.
+func WithSessionReuse(enabled bool) Option { return core.WithSessionReuse(enabled) }
+
+// Middleware wraps a Doer to observe or alter every RESTCONF call (type alias to core.Middleware).
+type Middleware = core.Middleware
+
+// Doer executes a RESTCONF request within the middleware chain.
+type Doer = core.Doer
+
+// DoerFunc adapts an ordinary function to the Doer interface.
+type DoerFunc = core.DoerFunc
+
+// Request describes a RESTCONF call seen by middlewares.
+type Request = core.Request
+
+// Response describes a RESTCONF response seen by middlewares.
+type Response = core.Response
+
+// WithMiddleware appends middlewares to the request chain; the first one is the outermost.
+func WithMiddleware(mw ...Middleware) Option { return core.WithMiddleware(mw...) }
+
+// WithHTTPClient replaces the underlying HTTP client (the value is copied).
+func WithHTTPClient(hc *http.Client) Option { return core.WithHTTPClient(hc) }
+
+// WithTransport replaces the HTTP transport used by the client.
+func WithTransport(rt http.RoundTripper) Option { return core.WithTransport(rt) }
+
+// RetryPolicy configures automatic retries (type alias to core.RetryPolicy).
+type RetryPolicy = core.RetryPolicy
+
+// DefaultRetryPolicy returns a retry policy populated with the package defaults.
+func DefaultRetryPolicy() RetryPolicy { return core.DefaultRetryPolicy() }
+
+// WithRateLimit limits requests to rps per second with bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) Option { return core.WithRateLimit(rps, burst) }
+
+// WithMaxConcurrentRequests caps the number of in-flight requests to the controller.
+func WithMaxConcurrentRequests(n int) Option { return core.WithMaxConcurrentRequests(n) }
+
+// WithRetryPolicy enables automatic retries with exponential backoff and Retry-After handling.
+func WithRetryPolicy(p RetryPolicy) Option { return core.WithRetryPolicy(p) }
+
+// CachePolicy configures the in-memory response cache (type alias to core.CachePolicy).
+type CachePolicy = core.CachePolicy
+
+// DefaultCachePolicy returns a cache policy populated with the package defaults.
+func DefaultCachePolicy() CachePolicy { return core.DefaultCachePolicy() }
+
+// WithCache caches successful GETs per RESTCONF URL and drops them after writes to the same YANG module.
+func WithCache(p CachePolicy) Option { return core.WithCache(p) }
+
+// ControllerState describes the observed role of a controller address (type alias to core.ControllerState).
+type ControllerState = core.ControllerState
+
+// ControllerStatus reports the redundancy state of one controller address.
+type ControllerStatus = core.ControllerStatus
+
+// Controller states reported by Redundancy.
+const (
+	ControllerActive      = core.ControllerActive
+	ControllerStandby     = core.ControllerStandby
+	ControllerUnreachable = core.ControllerUnreachable
+)
+
+// DefaultFailbackInterval is how often a more preferred controller is probed after a failover.
+const DefaultFailbackInterval = core.DefaultFailbackInterval
+
+// WithFailoverAddresses adds controller addresses used when the primary is unreachable, in order of preference.
+func WithFailoverAddresses(addresses ...string) Option {
+	return core.WithFailoverAddresses(addresses...)
+}
+
+// WithFailbackInterval sets how often a more preferred controller is probed after a failover.
+func WithFailbackInterval(d time.Duration) Option { return core.WithFailbackInterval(d) }
+
+// Backend carries single request attempts beneath the middleware chain (type alias to core.Backend).
+type Backend = core.Backend
+
+// WithBackend carries calls over backend instead of HTTPS.
+func WithBackend(b Backend) Option { return core.WithBackend(b) }
+
+// NETCONFConfig configures the NETCONF/SSH backend (type alias to core.NETCONFConfig).
+type NETCONFConfig = core.NETCONFConfig
+
+// WithNETCONF carries the service calls over NETCONF/SSH for controllers with RESTCONF disabled.
+func WithNETCONF(cfg NETCONFConfig) Option { return core.WithNETCONF(cfg) }
+
+// QueryOption sets an RFC 8040 query parameter on a single retrieval call (type alias to core.QueryOption).
+type QueryOption = core.QueryOption
+
+// RFC 8040 query parameter values accepted by QueryContent and QueryWithDefaults.
+const (
+	ContentConfig               = restconf.ContentConfig
+	ContentNonConfig            = restconf.ContentNonConfig
+	ContentAll                  = restconf.ContentAll
+	WithDefaultsReportAll       = restconf.WithDefaultsReportAll
+	WithDefaultsTrim            = restconf.WithDefaultsTrim
+	WithDefaultsExplicit        = restconf.WithDefaultsExplicit
+	WithDefaultsReportAllTagged = restconf.WithDefaultsReportAllTagged
+)
+
+// QueryFields limits the response to the given data nodes ("fields" parameter).
+func QueryFields(fields ...string) QueryOption { return core.QueryFields(fields...) }
+
+// QueryDepth limits the number of nested levels returned ("depth" parameter).
+func QueryDepth(depth int) QueryOption { return core.QueryDepth(depth) }
+
+// QueryContent selects configuration, operational or all data ("content" parameter).
+func QueryContent(content string) QueryOption { return core.QueryContent(content) }
+
+// QueryWithDefaults selects how default values are reported ("with-defaults" parameter).
+func QueryWithDefaults(mode string) QueryOption { return core.QueryWithDefaults(mode) }
+
 // Core returns the underlying core.Client for advanced use cases.
 // This should typically not be needed for normal usage.
 func (c *Client) Core() *core.Client {
 	return c.core
 }
 
+// ClearCache drops every cached response. It is a no-op when caching is disabled.
+func (c *Client) ClearCache() {
+	c.core.ClearCache()
+}
+
+// Close releases the connections held by the backend, such as NETCONF sessions.
+func (c *Client) Close() error {
+	return c.core.Close()
+}
+
+// ActiveController returns the controller address currently receiving requests.
+func (c *Client) ActiveController() string {
+	return c.core.ActiveController()
+}
+
+// Redundancy returns the observed state of every controller address in priority order.
+func (c *Client) Redundancy() []ControllerStatus {
+	return c.core.Redundancy()
+}
+
 // Domain service accessors - each returns a service instance for the respective domain
 
 // AFC returns the Automated Frequency Coordination service.
@@ -277,3 +559,29 @@ func (c *Client) RFTag() *rf.RFTagService {
 func (c *Client) SiteTag() *site.SiteTagService {
 	return site.NewSiteTagService(c.core)
 }
+
+// Profile service accessors - provide direct access to profile management services
+
+// PolicyProfile returns the Policy Profile service for policy profile management operations.
+// This provides direct access to policy profile CRUD operations without going through WLAN service.
+func (c *Client) PolicyProfile() *wlan.PolicyProfileService {
+	return wlan.NewPolicyProfileService(c.core)
+}
+
+// RFProfile returns the RF Profile service for RF profile management operations.
+// This provides direct access to RF profile CRUD operations without going through RF service.
+func (c *Client) RFProfile() *rf.RFProfileService {
+	return rf.NewRFProfileService(c.core)
+}
+
+// FlexProfile returns the Flex Profile service for FlexConnect profile management operations.
+// This provides direct access to flex profile CRUD operations without going through Flex service.
+func (c *Client) FlexProfile() *flex.FlexProfileService {
+	return flex.NewFlexProfileService(c.core)
+}
+
+// APJoinProfile returns the AP Join Profile service for AP join profile management operations.
+// This provides direct access to AP join profile CRUD operations without going through Site service.
+func (c *Client) APJoinProfile() *site.APJoinProfileService {
+	return site.NewAPJoinProfileService(c.core)
+}
@@ -1,6 +1,8 @@
 package wnc
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"testing"
 	"time"
@@ -36,6 +38,13 @@ func TestNewClient(t *testing.T) {
 			opts:        []Option{WithLogger(slog.New(slog.DiscardHandler)), WithUserAgent("custom-agent/1.0")},
 			expectError: false,
 		},
+		{
+			name:        "ValidClientWithRetryPolicy",
+			host:        "controller.example.internal",
+			token:       "YWRtaW46cGFzc3dvcmQ=",
+			opts:        []Option{WithRetryPolicy(DefaultRetryPolicy())},
+			expectError: false,
+		},
 		{
 			name:        "InvalidHost",
 			host:        "",
@@ -133,4 +142,74 @@ func TestClientServiceAccessors(t *testing.T) {
 	_ = client.PolicyTag() // Should not panic
 	_ = client.RFTag()     // Should not panic
 	_ = client.SiteTag()   // Should not panic
+
+	// Test profile service accessors
+	_ = client.PolicyProfile() // Should not panic
+	_ = client.RFProfile()     // Should not panic
+	_ = client.FlexProfile()   // Should not panic
+	_ = client.APJoinProfile() // Should not panic
+}
+
+// TestNewClientWithCredentials tests the username/password constructor.
+func TestNewClientWithCredentials(t *testing.T) {
+	testCases := []struct {
+		name        string
+		username    string
+		password    string
+		expectError bool
+	}{
+		{name: "ValidCredentials", username: "admin", password: "password", expectError: false},
+		{name: "EmptyUsername", username: "", password: "password", expectError: true},
+		{name: "EmptyPassword", username: "admin", password: "", expectError: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientWithCredentials("controller.example.com", tt.username, tt.password)
+			if tt.expectError && err == nil {
+				t.Error("Expected error, but got none")
+			}
+			if !tt.expectError && (err != nil || client == nil) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestNewClientWithAuthenticator tests the pluggable authenticator constructor.
+func TestNewClientWithAuthenticator(t *testing.T) {
+	auth := NewCredentialAuth(EnvCredentials("WNC_USERNAME", "WNC_PASSWORD"))
+	client, err := NewClientWithAuthenticator("controller.example.com", auth, WithSessionReuse(true))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client == nil {
+		t.Fatal("Expected client, but got nil")
+	}
+
+	if _, err := NewClientWithAuthenticator("controller.example.com", nil); err == nil {
+		t.Error("Expected error for nil authenticator")
+	}
+}
+
+// TestRESTCONFErrorHelpers tests structured RESTCONF errors through the re-exported APIError.
+func TestRESTCONFErrorHelpers(t *testing.T) {
+	err := fmt.Errorf("set tag: %w", &APIError{
+		StatusCode: 409,
+		Errors:     []RESTCONFError{{Type: "application", Tag: ErrorTagDataExists, Message: "object already exists"}},
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatal("Expected errors.As to find APIError")
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Message != "object already exists" {
+		t.Errorf("Unexpected RESTCONF errors: %+v", apiErr.Errors)
+	}
+	if !IsDataExists(err) || !HasErrorTag(err, ErrorTagDataExists) {
+		t.Error("Expected data-exists error")
+	}
+	if IsLockDenied(err) || IsInvalidValue(err) || IsAccessDenied(err) || IsDataMissing(err) {
+		t.Error("Unexpected error-tag match")
+	}
 }